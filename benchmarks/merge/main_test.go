@@ -0,0 +1,85 @@
+package merge
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/conneroisu/twerge"
+)
+
+// Class strings with various characteristics
+var (
+	// Short string without conflicts
+	simpleClasses = "flex items-center gap-2"
+
+	// String where most classes are overridden
+	conflictClasses = "p-2 px-4 py-1 p-3 m-1 mx-2 text-sm text-lg font-bold font-normal"
+
+	// Long string with variants, as rendered by a dashboard card
+	dashboardClasses = "min-h-screen bg-gray-50 text-gray-900 flex flex-col hover:bg-gray-100 " +
+		"dark:bg-gray-900 dark:text-gray-100 md:flex-row md:p-8 p-4 rounded-lg shadow-md " +
+		"focus:outline-none focus:ring-2 focus:ring-blue-500 dark:hover:bg-gray-800"
+
+	// String with arbitrary values, postfixes and important modifiers
+	arbitraryClasses = "w-[10px] h-1/2 !p-4 text-lg/7 bg-red-500/50 [mask-type:luminance] " +
+		"grid-cols-[1fr_2fr] shadow-[0_35px_60px_-15px_rgba(0,0,0,0.3)] w-[20px]"
+)
+
+func BenchmarkMergeSimple(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.Merge(simpleClasses)
+	}
+}
+
+func BenchmarkMergeConflict(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.Merge(conflictClasses)
+	}
+}
+
+func BenchmarkMergeDashboard(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.Merge(dashboardClasses)
+	}
+}
+
+func BenchmarkMergeArbitrary(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.Merge(arbitraryClasses)
+	}
+}
+
+// BenchmarkItCached measures the lookup of already generated classes, the
+// common case when rendering.
+func BenchmarkItCached(b *testing.B) {
+	g := twerge.New(twerge.NewHandler(nil))
+	g.It(dashboardClasses)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		g.It(dashboardClasses)
+	}
+}
+
+// BenchmarkItUncached measures generating a class for new class strings.
+func BenchmarkItUncached(b *testing.B) {
+	g := twerge.New(twerge.NewHandler(nil))
+	inputs := make([]string, 1024)
+	for i := range inputs {
+		inputs[i] = dashboardClasses + " z-" + strconv.Itoa(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	i := 0
+	for b.Loop() {
+		if i%len(inputs) == 0 {
+			g.Handler.SetCache(make(map[string]twerge.CacheValue))
+		}
+		g.It(inputs[i%len(inputs)])
+		i++
+	}
+}
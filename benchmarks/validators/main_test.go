@@ -0,0 +1,93 @@
+package validators
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/conneroisu/twerge"
+)
+
+// Regular expression patterns the validators used to match with
+var (
+	fractionPattern  = regexp.MustCompile(`^\d+\/\d+$`)
+	shirtPattern     = regexp.MustCompile(`^(\d+(\.\d+)?)?(xs|sm|md|lg|xl)$`)
+	arbitraryPattern = regexp.MustCompile(`(?i)^\[(?:([a-z-]+):)?(.+)\]$`)
+)
+
+// Values with various characteristics
+var (
+	fraction  = "11/12"
+	shirtSize = "2xl"
+	number    = "-2.5"
+	arbitrary = "[length:10px]"
+)
+
+// Benchmark functions for fractions like w-1/2
+func BenchmarkRegexCompileFraction(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		regexp.MustCompile(`^\d+\/\d+$`).MatchString(fraction)
+	}
+}
+
+func BenchmarkRegexFraction(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		fractionPattern.MatchString(fraction)
+	}
+}
+
+func BenchmarkScannerFraction(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.IsLength(fraction)
+	}
+}
+
+// Benchmark functions for t-shirt sizes like shadow-2xl
+func BenchmarkRegexTshirtSize(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		shirtPattern.MatchString(shirtSize)
+	}
+}
+
+func BenchmarkScannerTshirtSize(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.IsTshirtSize(shirtSize)
+	}
+}
+
+// Benchmark functions for numbers like opacity-50
+func BenchmarkStrconvNumber(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := strconv.Atoi(number); err != nil {
+			_, _ = strconv.ParseFloat(number, 64)
+		}
+	}
+}
+
+func BenchmarkScannerNumber(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.IsNumber(number)
+	}
+}
+
+// Benchmark functions for arbitrary values like w-[length:10px]
+func BenchmarkRegexArbitraryValue(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		arbitraryPattern.FindStringSubmatch(arbitrary)
+	}
+}
+
+func BenchmarkScannerArbitraryValue(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		twerge.IsArbitraryValue(arbitrary)
+	}
+}
@@ -0,0 +1,215 @@
+package twerge
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Class is a single class split into its parts.
+//
+// Example: hover:!-mt-[10px]/50 has the variants [hover], is important and
+// negative, and has the base mt-[10px], the postfix 50 and the arbitrary
+// value 10px.
+type Class struct {
+	// Variants are the modifiers of the class in their original order.
+	//
+	// Example: [md hover]
+	Variants []string
+	// Important is true if the class has the important modifier, before or
+	// after the utility.
+	Important bool
+	// Base is the utility without its variants, important modifier,
+	// negative sign, prefix and postfix.
+	//
+	// Example: bg-red-500
+	Base string
+	// Postfix is the postfix modifier of the class without the separator.
+	//
+	// Example: 50
+	Postfix string
+	// ArbitraryValue is the arbitrary value of the base without its
+	// brackets and label, or the css variable of the v4 shorthand.
+	//
+	// Example: 10px of w-[length:10px], --size of w-(--size)
+	ArbitraryValue string
+	// ArbitraryLabel is the label of the arbitrary value, or the property
+	// of an arbitrary property.
+	//
+	// Example: length of w-[length:10px], mask-type of
+	// [mask-type:luminance]
+	ArbitraryLabel string
+	// Negative is true if the base is preceded by the negative sign.
+	//
+	// Example: -mt-4
+	Negative bool
+	// GroupID is the class group the class belongs to, empty if the class
+	// is not a Tailwind class.
+	//
+	// Example: bg-color
+	GroupID string
+
+	// config holds the separators of the class, nil for the default ones
+	config *Config
+	// prefixed is true if the class has the prefix of the config
+	prefixed bool
+	// trailing is true for the v4 important modifier after the utility
+	trailing bool
+}
+
+// ParseClass splits a single class into its parts.
+//
+// It returns a [*ParseError] for a malformed class, and for a token that is
+// empty or holds more than one class.
+//
+// Example: ParseClass("hover:bg-red-500/50") -> variants [hover], base
+// bg-red-500 and postfix 50
+func ParseClass(token string) (Class, error) {
+	return Default().ParseClass(token)
+}
+
+// ParseClasses splits every whitespace separated class into its parts.
+//
+// Malformed classes are returned with the whole class as their base, so
+// that no class is lost.
+func ParseClasses(s string) []Class {
+	return Default().ParseClasses(s)
+}
+
+// ParseClass splits a single class into its parts.
+func (g *Generator) ParseClass(token string) (Class, error) {
+	return g.mergeHandler().ParseClass(token)
+}
+
+// ParseClasses splits every whitespace separated class into its parts.
+func (g *Generator) ParseClasses(s string) []Class {
+	return g.mergeHandler().ParseClasses(s)
+}
+
+// ParseClass splits a single class into its parts.
+func (g *defaultHandler) ParseClass(token string) (Class, error) {
+	if strings.IndexFunc(token, unicode.IsSpace) != -1 {
+		return Class{}, &ParseError{Class: token, Reason: "more than one class"}
+	}
+	decision, err := g.parse(token, nil)
+	if err != nil {
+		return Class{}, err
+	}
+	return g.class(decision), nil
+}
+
+// ParseClasses splits every whitespace separated class into its parts.
+func (g *defaultHandler) ParseClasses(s string) []Class {
+	var classes []Class
+	for token := range strings.FieldsSeq(s) {
+		decision, err := g.parse(token, nil)
+		if err != nil {
+			classes = append(classes, Class{Base: token})
+			continue
+		}
+		classes = append(classes, g.class(decision))
+	}
+	return classes
+}
+
+// class splits a parsed class into its parts.
+func (g *defaultHandler) class(decision Decision) Class {
+	c := Class{
+		Variants:  decision.Modifiers,
+		Important: decision.Important,
+		Postfix:   decision.Postfix,
+		GroupID:   decision.GroupID,
+		config:    g.config,
+	}
+	if g.config.isVariantPrefix() {
+		c.prefixed = strings.HasPrefix(decision.Class, g.config.Prefix)
+	}
+	_, base, trail := g.splitUtility(decision)
+	c.trailing = trail != ""
+	if !decision.IsTailwind {
+		c.Base = base
+		return c
+	}
+
+	negative := string(g.config.ClassSeparator)
+	if g.config.Prefix != "" && !g.config.isVariantPrefix() {
+		// the negative sign comes before the v3 prefix -> -tw-m-2
+		c.Negative = strings.HasPrefix(base, negative+g.config.Prefix)
+		base = strings.TrimPrefix(base, negative)
+		base, c.prefixed = strings.CutPrefix(base, g.config.Prefix)
+	} else {
+		base, c.Negative = strings.CutPrefix(base, negative)
+	}
+	if c.Postfix != "" {
+		base = base[:len(base)-len(c.Postfix)-len(string(g.config.PostfixModifier))]
+	}
+	c.Base = base
+
+	if property, ok := arbitraryProperty(c.GroupID); ok {
+		c.ArbitraryLabel = property
+		c.ArbitraryValue = base[len(property)+2 : len(base)-1]
+		return c
+	}
+	value := arbitrarySuffix(base, g.config.ClassSeparator)
+	if label, inner, ok := cutArbitraryValue(value); ok {
+		c.ArbitraryLabel, c.ArbitraryValue = label, inner
+	} else if label, variable, ok := cutArbitraryVariable(value); ok {
+		c.ArbitraryLabel, c.ArbitraryValue = label, variable
+	}
+	return c
+}
+
+// arbitrarySuffix returns the bracketed value at the end of a base class ->
+// [10px] of w-[10px], or an empty string.
+func arbitrarySuffix(base string, separator rune) string {
+	depth, start := 0, -1
+	for i := range len(base) {
+		switch base[i] {
+		case '[', '(':
+			if depth == 0 && (i == 0 || rune(base[i-1]) == separator) {
+				start = i
+			}
+			depth++
+		case ']', ')':
+			depth--
+			if depth == 0 && start != -1 && i == len(base)-1 {
+				return base[start:]
+			}
+		}
+	}
+	return ""
+}
+
+// String returns the class as it was parsed, with the changes made to its
+// parts.
+func (c Class) String() string {
+	config := c.config
+	if config == nil {
+		config = defaultConfig()
+	}
+	var b strings.Builder
+	if c.prefixed && config.isVariantPrefix() {
+		b.WriteString(config.Prefix)
+	}
+	for _, variant := range c.Variants {
+		b.WriteString(variant)
+		b.WriteRune(config.ModifierSeparator)
+	}
+	if c.Important && !c.trailing {
+		b.WriteRune(config.ImportantModifier)
+	}
+	if c.Negative {
+		b.WriteRune(config.ClassSeparator)
+	}
+	if c.prefixed && !config.isVariantPrefix() {
+		b.WriteString(config.Prefix)
+	}
+	b.WriteString(c.Base)
+	if c.Postfix != "" {
+		b.WriteRune(config.PostfixModifier)
+		b.WriteString(c.Postfix)
+	}
+	if c.Important && c.trailing {
+		b.WriteRune(config.ImportantModifier)
+	}
+	return b.String()
+}
@@ -0,0 +1,118 @@
+package twerge
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseClass(t *testing.T) {
+	g := New(newDefaultHandler())
+	tests := []struct {
+		in   string
+		want Class
+	}{
+		{"p-4", Class{Base: "p-4", GroupID: "p"}},
+		{
+			"md:hover:!bg-red-500/50",
+			Class{
+				Variants:  []string{"md", "hover"},
+				Important: true,
+				Base:      "bg-red-500",
+				Postfix:   "50",
+				GroupID:   "bg-color",
+			},
+		},
+		{"-mt-4!", Class{Important: true, Base: "mt-4", Negative: true, GroupID: "mt"}},
+		{
+			"w-[length:10px]",
+			Class{Base: "w-[length:10px]", ArbitraryValue: "10px", ArbitraryLabel: "length", GroupID: "w"},
+		},
+		{
+			"[&>*]:grid-cols-[1fr_auto]",
+			Class{
+				Variants:       []string{"[&>*]"},
+				Base:           "grid-cols-[1fr_auto]",
+				ArbitraryValue: "1fr_auto",
+				GroupID:        "grid-cols",
+			},
+		},
+		{
+			"[mask-type:luminance]",
+			Class{
+				Base:           "[mask-type:luminance]",
+				ArbitraryValue: "luminance",
+				ArbitraryLabel: "mask-type",
+				GroupID:        "arbitrary..mask-type",
+			},
+		},
+		{"focus:custom/5", Class{Variants: []string{"focus"}, Base: "custom/5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := g.ParseClass(tt.in)
+			if err != nil {
+				t.Fatalf("ParseClass() error = %v", err)
+			}
+			if got.String() != tt.in {
+				t.Errorf("String() = %q, wanted %q", got.String(), tt.in)
+			}
+			got.config, got.prefixed, got.trailing = nil, false, false
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseClass() = %+v, wanted %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseClassErrors(t *testing.T) {
+	for _, in := range []string{"", "hover:", "w-[10px", "p-4 m-2"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseClass(in)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Errorf("ParseClass() error = %v, wanted a *ParseError", err)
+			}
+		})
+	}
+}
+
+func TestParseClassesRoundTrip(t *testing.T) {
+	tests := []struct {
+		prefix string
+		in     string
+	}{
+		{"", "p-4 hover:!-mx-2 w-[10px] bg-(--brand) text-lg/7 w-[10px custom"},
+		{"tw:", "tw:hover:p-4 tw:-mt-4! tw:bg-red-500/50 p-4"},
+		{"tw-", "hover:tw-p-4 -tw-mt-4 !tw-bg-red-500/50 p-4"},
+		{"tw-", "w-1/2 hover:text-lg/7 tw-w-1/2"},
+		{"tw:", "w-1/2 hover:text-lg/7 tw:w-1/2 tw:text-lg/7!"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.prefix+" "+tc.in, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Prefix = tc.prefix
+			classes := New(NewHandler(cfg)).ParseClasses(tc.in)
+			got := make([]string, len(classes))
+			for i, class := range classes {
+				got[i] = class.String()
+			}
+			if want := strings.Fields(tc.in); !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseClasses() = %q, wanted %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseClassesPrefix(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Prefix = "tw-"
+	classes := New(NewHandler(cfg)).ParseClasses("-tw-mt-4 p-4")
+	if c := classes[0]; c.Base != "mt-4" || !c.Negative || c.GroupID != "mt" {
+		t.Errorf("ParseClasses()[0] = %+v, wanted the negative mt-4", c)
+	}
+	if c := classes[1]; c.Base != "p-4" || c.GroupID != "" {
+		t.Errorf("ParseClasses()[1] = %+v, wanted a class without prefix", c)
+	}
+}
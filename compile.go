@@ -0,0 +1,306 @@
+package twerge
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// classTable is a [Config] compiled for lookups.
+//
+// The class group trie is flattened into slices, children are found by a
+// binary search over the sorted parts of a node and class group IDs are
+// interned so that conflicts are bitsets indexed by class group.
+type classTable struct {
+	// interned class group IDs, the index is the class group
+	groupIDs []string
+	groups   map[string]int32
+
+	nodes      []tableNode
+	edges      []tableEdge
+	validators []tableValidator
+
+	// words is the number of uint64 in a class group bitset
+	words int
+	// conflicts and modifierConflicts hold a bitset of words for every
+	// class group
+	conflicts         []uint64
+	modifierConflicts []uint64
+
+	// css properties of every class group, and the class groups
+	// overridden by an arbitrary property as a bitset by property
+	properties   [][]string
+	overriddenBy map[string][]uint64
+}
+
+// tableNode is a [ClassPart] of a classTable, its children and validators
+// are the ranges [edges, edgesEnd) and [validators, validatorsEnd).
+type tableNode struct {
+	group                     int32
+	edges, edgesEnd           int32
+	validators, validatorsEnd int32
+}
+
+type tableEdge struct {
+	part string
+	node int32
+}
+
+type tableValidator struct {
+	fn    func(string) bool
+	group int32
+}
+
+// noGroup is the class group of classes that are not in the table.
+const noGroup int32 = -1
+
+// compiledTable is the compiled table of a config, along with the config it
+// was compiled from so that a copy of the config compiles its own.
+type compiledTable struct {
+	config *Config
+	table  *classTable
+}
+
+// compileMu guards the compiled tables of the configs.
+var compileMu sync.Mutex
+
+// table returns the compiled config.
+//
+// The config is compiled on first use and keeps its table, so it must not
+// be modified after it is used to merge classes.
+func (c *Config) table() *classTable {
+	compileMu.Lock()
+	defer compileMu.Unlock()
+	if c.compiled == nil || c.compiled.config != c {
+		c.compiled = &compiledTable{config: c, table: c.compile()}
+	}
+	return c.compiled.table
+}
+
+// compile flattens the class group trie and the conflicts of the config.
+func (c *Config) compile() *classTable {
+	t := &classTable{groups: make(map[string]int32)}
+	themeValidators := make(map[string]func(string) bool)
+
+	// breadth first, so that the children of a node are next to each other
+	queue := []*ClassPart{&c.ClassGroups}
+	for i := 0; i < len(queue); i++ {
+		part := queue[i]
+		node := tableNode{group: t.intern(part.ClassGroupID)}
+
+		node.validators = int32(len(t.validators))
+		for _, validator := range part.Validators {
+			fn := validator.Fn
+			if fn == nil {
+				if themeValidators[validator.Theme] == nil {
+					themeValidators[validator.Theme] = c.Theme.validator(validator.Theme)
+				}
+				fn = themeValidators[validator.Theme]
+			}
+			t.validators = append(t.validators, tableValidator{
+				fn:    fn,
+				group: t.intern(validator.ClassGroupID),
+			})
+		}
+		node.validatorsEnd = int32(len(t.validators))
+
+		node.edges = int32(len(t.edges))
+		for _, key := range slices.Sorted(maps.Keys(part.NextPart)) {
+			t.edges = append(t.edges, tableEdge{part: key, node: int32(len(queue))})
+			queue = append(queue, part.NextPart[key])
+		}
+		node.edgesEnd = int32(len(t.edges))
+		t.nodes = append(t.nodes, node)
+	}
+
+	// conflicting groups might not be in the trie -> leading
+	for _, conflicts := range []ConflictingClassGroups{
+		c.ConflictingClassGroups,
+		c.ConflictingClassGroupModifiers,
+	} {
+		for _, groupID := range slices.Sorted(maps.Keys(conflicts)) {
+			t.intern(groupID)
+			for _, conflict := range conflicts[groupID] {
+				t.intern(conflict)
+			}
+		}
+	}
+
+	t.words = (len(t.groupIDs) + 63) / 64
+	t.conflicts = t.bitsets(c.ConflictingClassGroups)
+	t.modifierConflicts = t.bitsets(c.ConflictingClassGroupModifiers)
+	t.compileProperties(c.Properties)
+	return t
+}
+
+// compileProperties sets the css properties of the class groups and the
+// class groups overridden by every property that sets all of their
+// properties -> padding overrides p, px and pt.
+func (t *classTable) compileProperties(properties ClassGroupProperties) {
+	t.properties = make([][]string, len(t.groupIDs))
+	t.overriddenBy = make(map[string][]uint64)
+	for group, groupID := range t.groupIDs {
+		t.properties[group] = properties[groupID]
+	}
+
+	var candidates []string
+	for _, groupProperties := range t.properties {
+		candidates = append(candidates, groupProperties...)
+	}
+	candidates = append(candidates, slices.Collect(maps.Keys(shorthands))...)
+	for _, property := range candidates {
+		if t.overriddenBy[property] != nil {
+			continue
+		}
+		set := make([]uint64, t.words)
+		for group, groupProperties := range t.properties {
+			if len(groupProperties) > 0 && !slices.ContainsFunc(
+				groupProperties,
+				func(other string) bool { return !overrides(property, other) },
+			) {
+				setBit(set, int32(group))
+			}
+		}
+		t.overriddenBy[property] = set
+	}
+}
+
+// intern returns the class group of the class group ID.
+func (t *classTable) intern(groupID string) int32 {
+	if groupID == "" {
+		return noGroup
+	}
+	if group, ok := t.groups[groupID]; ok {
+		return group
+	}
+	group := int32(len(t.groupIDs))
+	t.groupIDs = append(t.groupIDs, groupID)
+	t.groups[groupID] = group
+	return group
+}
+
+// bitsets returns the conflicts as a bitset for every class group.
+func (t *classTable) bitsets(conflicts ConflictingClassGroups) []uint64 {
+	sets := make([]uint64, len(t.groupIDs)*t.words)
+	for groupID, groups := range conflicts {
+		set := t.bitset(sets, t.groups[groupID])
+		for _, conflict := range groups {
+			setBit(set, t.groups[conflict])
+		}
+	}
+	return sets
+}
+
+// bitset returns the bitset of the class group in sets.
+func (t *classTable) bitset(sets []uint64, group int32) []uint64 {
+	return sets[int(group)*t.words : int(group+1)*t.words]
+}
+
+// lookup returns the class group of a base class, or noGroup if it is not
+// a Tailwind class.
+func (t *classTable) lookup(baseClass string, separator rune) int32 {
+	// remove the leading separator for things like -px-4
+	rest, _ := strings.CutPrefix(baseClass, string(separator))
+	return t.lookupNode(0, rest, baseClass != "", separator)
+}
+
+// isLiteral returns true if the base class is a class of the table without
+// going through a validator -> bg-brand added with a ClassDefinition.
+func (t *classTable) isLiteral(baseClass string, separator rune) bool {
+	index := int32(0)
+	for part := range strings.SplitSeq(baseClass, string(separator)) {
+		node := &t.nodes[index]
+		edges := t.edges[node.edges:node.edgesEnd]
+		i := sort.Search(len(edges), func(i int) bool { return edges[i].part >= part })
+		if i == len(edges) || edges[i].part != part {
+			return false
+		}
+		index = edges[i].node
+	}
+	return t.nodes[index].group != noGroup
+}
+
+// lookupNode walks the table along the parts of the class, rest holds the
+// parts left to walk and hasParts whether there are any -> "" is one empty
+// part after p-.
+func (t *classTable) lookupNode(
+	index int32,
+	rest string,
+	hasParts bool,
+	separator rune,
+) int32 {
+	node := &t.nodes[index]
+	if !hasParts {
+		return node.group
+	}
+
+	if node.edges != node.edgesEnd {
+		part, next, hasNext := rest, "", false
+		if i := strings.IndexRune(rest, separator); i != -1 {
+			part = rest[:i]
+			next = rest[i+utf8.RuneLen(separator):]
+			hasNext = true
+		}
+		edges := t.edges[node.edges:node.edgesEnd]
+		i := sort.Search(len(edges), func(i int) bool { return edges[i].part >= part })
+		if i < len(edges) && edges[i].part == part {
+			if group := t.lookupNode(edges[i].node, next, hasNext, separator); group != noGroup {
+				return group
+			}
+		}
+	}
+
+	for _, validator := range t.validators[node.validators:node.validatorsEnd] {
+		if validator.fn(rest) {
+			return validator.group
+		}
+	}
+	return noGroup
+}
+
+// claims returns true if a class of the class group, with or without a
+// postfix, overrides the other class group.
+//
+// Arbitrary properties are numbered after the class groups of the table,
+// their css properties are looked up in arbitrary.
+func (t *classTable) claims(group int32, postfix bool, other int32, arbitrary []string) bool {
+	if group == other {
+		return true
+	}
+	groups := int32(len(t.groupIDs))
+	switch {
+	case group >= groups && other >= groups:
+		return overrides(t.arbitraryProperty(group, arbitrary), t.arbitraryProperty(other, arbitrary))
+	case group >= groups:
+		return hasBit(t.overriddenBy[t.arbitraryProperty(group, arbitrary)], other)
+	case other >= groups:
+		return setsProperty(t.properties[group], t.arbitraryProperty(other, arbitrary))
+	}
+	return hasBit(t.bitset(t.conflicts, group), other) ||
+		postfix && hasBit(t.bitset(t.modifierConflicts, group), other)
+}
+
+// arbitraryProperty returns the css property of the arbitrary property
+// numbered after the class groups of the table.
+func (t *classTable) arbitraryProperty(group int32, arbitrary []string) string {
+	property, _ := arbitraryProperty(arbitrary[int(group)-len(t.groupIDs)])
+	return property
+}
+
+func setBit(set []uint64, bit int32) {
+	set[bit/64] |= 1 << (bit % 64)
+}
+
+func hasBit(set []uint64, bit int32) bool {
+	return int(bit/64) < len(set) && set[bit/64]&(1<<(bit%64)) != 0
+}
+
+// orBits sets the bits of src in dst, which may be longer.
+func orBits(dst, src []uint64) {
+	for i, word := range src {
+		dst[i] |= word
+	}
+}
@@ -0,0 +1,111 @@
+package twerge
+
+import (
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestClassTable(t *testing.T) {
+	table := defaultConfig().table()
+
+	lookups := map[string]string{
+		"p-4":          "p",
+		"-mx-2":        "mx",
+		"p-":           "",
+		"bg-red-500":   "bg-color",
+		"w-1/2":        "w",
+		"text-lg":      "font-size",
+		"shadow-2xl":   "shadow",
+		"not-a-class":  "",
+		"line-clamp-2": "line-clamp",
+	}
+	for class, want := range lookups {
+		got := ""
+		if group := table.lookup(class, '-'); group != noGroup {
+			got = table.groupIDs[group]
+		}
+		if got != want {
+			t.Errorf("lookup(%q) = %q, want %q", class, got, want)
+		}
+	}
+
+	for groupID, conflicts := range defaultConfig().ConflictingClassGroups {
+		for _, conflict := range conflicts {
+			if !table.claims(table.groups[groupID], false, table.groups[conflict], nil) {
+				t.Errorf("%s should claim %s", groupID, conflict)
+			}
+		}
+	}
+	if table.claims(table.groups["px"], false, table.groups["p"], nil) {
+		t.Error("px should not claim p")
+	}
+	if !table.claims(table.groups["font-size"], true, table.groups["leading"], nil) {
+		t.Error("font-size with a postfix should claim leading")
+	}
+
+	if defaultConfig().table() != table {
+		t.Error("table() should compile the config once")
+	}
+}
+
+func TestConfigTable(t *testing.T) {
+	cfg := DefaultConfig()
+	table := cfg.table()
+	if cfg.table() != table {
+		t.Errorf("table() should compile the config once")
+	}
+	// a copy made after the config was compiled compiles its own table
+	copied := *cfg
+	copied.ConflictingClassGroups = ConflictingClassGroups{}
+	if copied.table() == table {
+		t.Errorf("table() of a copy should not reuse the table of the config")
+	}
+	if got := New(NewHandler(&copied)).Merge("p-2 px-4"); got != "p-2 px-4" {
+		t.Errorf("Merge() = %s, wanted the conflicts of the copy", got)
+	}
+	if DefaultConfig().compiled != nil {
+		t.Errorf("DefaultConfig() should not share the table of the default config")
+	}
+}
+
+// initTrace matches the package init line of GODEBUG=inittrace=1.
+var initTrace = regexp.MustCompile(
+	`init github.com/conneroisu/twerge @\S+ ms, ([\d.]+) ms clock, (\d+) bytes, (\d+) allocs`,
+)
+
+// BenchmarkInit measures the package init by running the test binary with
+// GODEBUG=inittrace=1, the default config is not built before first use.
+func BenchmarkInit(b *testing.B) {
+	var clock, bytes, allocs float64
+	for b.Loop() {
+		cmd := exec.Command(os.Args[0], "-test.run=^$")
+		cmd.Env = append(os.Environ(), "GODEBUG=inittrace=1")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			b.Fatalf("running %s: %v\n%s", os.Args[0], err, out)
+		}
+		m := initTrace.FindSubmatch(out)
+		if m == nil {
+			b.Fatalf("no init trace of twerge in:\n%s", out)
+		}
+		ms, _ := strconv.ParseFloat(string(m[1]), 64)
+		n, _ := strconv.ParseFloat(string(m[2]), 64)
+		a, _ := strconv.ParseFloat(string(m[3]), 64)
+		clock, bytes, allocs = clock+ms, bytes+n, allocs+a
+	}
+	b.ReportMetric(clock/float64(b.N), "init-ms/op")
+	b.ReportMetric(bytes/float64(b.N), "init-B/op")
+	b.ReportMetric(allocs/float64(b.N), "init-allocs/op")
+}
+
+// BenchmarkDefaultConfig measures the first use of the default config, the
+// cost moved out of the package init.
+func BenchmarkDefaultConfig(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		newDefaultConfig().table()
+	}
+}
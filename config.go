@@ -2,7 +2,8 @@ package twerge
 
 import (
 	"regexp"
-	"strconv"
+	"strings"
+	"sync"
 )
 
 var (
@@ -11,19 +12,29 @@ var (
 		"full":   true,
 		"screen": true,
 	}
-	lengthUnitRegex        = regexp.MustCompile(`\d+(%|px|r?em|[sdl]?v([hwib]|min|max)|pt|pc|in|cm|mm|cap|ch|ex|r?lh|cq(w|h|i|b|min|max))|\b(calc|min|max|clamp)\(.+\)|^0$`)
-	colorFnRegex           = regexp.MustCompile(`^(rgba?|hsla?|hwb|(ok)?(lab|lch))\(.+\)$`)
-	arbitraryRegex         = regexp.MustCompile(`(?i)^\[(?:([a-z-]+):)?(.+)\]$`)
-	shirtPattern           = regexp.MustCompile(`^(\d+(\.\d+)?)?(xs|sm|md|lg|xl)$`)
-	shardowPattern         = regexp.MustCompile(`^(inset_)?-?((\d+)?\.?(\d+)[a-z]+|0)_-?((\d+)?\.?(\d+)[a-z]+|0)`)
-	arbitraryPropertyRegex = regexp.MustCompile(`^\[(.+)\]$`)
-	sizeLabels             = map[string]bool{"length": true, "size": true, "percentage": true}
-	imageLabels            = map[string]bool{"image": true, "url": true}
+	lengthUnitRegex = regexp.MustCompile(`\d+(%|px|r?em|[sdl]?v([hwib]|min|max)|pt|pc|in|cm|mm|cap|ch|ex|r?lh|cq(w|h|i|b|min|max))|\b(calc|min|max|clamp)\(.+\)|^0$`)
+	colorFnRegex    = regexp.MustCompile(`^(rgba?|hsla?|hwb|(ok)?(lab|lch))\(.+\)$`)
+	shardowPattern  = regexp.MustCompile(`^(inset_)?-?((\d+)?\.?(\d+)[a-z]+|0)_-?((\d+)?\.?(\d+)[a-z]+|0)`)
+	sizeLabels      = map[string]bool{"length": true, "size": true, "percentage": true}
+	imageLabels     = map[string]bool{"image": true, "url": true}
 )
 
-// config is the configuration for the template merger
+// Version is the major version of Tailwind CSS targeted by a [Config].
+type Version int
+
+const (
+	// V3 targets Tailwind CSS v3. It is the default.
+	V3 Version = 3
+	// V4 targets Tailwind CSS v4.
+	V4 Version = 4
+)
+
+// Config is the configuration for the template merger.
+//
+// Use [ExtendConfig] or [MergeConfig] to build on top of the default
+// configuration and [NewHandler] to use it.
 type (
-	config struct {
+	Config struct {
 		// defaults should be good enough
 		// hover:bg-red-500 -> :
 		ModifierSeparator rune
@@ -33,66 +44,143 @@ type (
 		ImportantModifier rune
 		// used for bg-red-500/50 (50% opacity) -> /
 		PostfixModifier rune
-		// optional
+		// Tailwind CSS version the class groups are for, see
+		// DefaultConfigFor. V4 adds the class groups of v4 to a config
+		// built on the v3 groups when it is passed to NewHandler.
+		Version Version
+		// optional, tw- for Tailwind CSS v3 (tw-p-4) or tw: for
+		// Tailwind CSS v4 (tw:p-4)
 		Prefix string
 		// CACHE
 		MaxCacheSize int
 		// This is a large map of all the classes and their validators -> see default-config.go
-		ClassGroups classPart
+		ClassGroups ClassPart
 		// class group with conflict + conflicting groups -> if "p" is set all others are removed
 		// p: ['px', 'py', 'ps', 'pe', 'pt', 'pr', 'pb', 'pl']
-		ConflictingClassGroups conflictingClassGroups
+		ConflictingClassGroups ConflictingClassGroups
+		// known variants, a trailing -* matches by prefix -> aria-*
+		Variants []string
+		// modifiers that can not be reordered without changing the
+		// selector, a trailing -* matches by prefix -> has-*
+		OrderSensitiveModifiers []string
+		// class group with a postfix modifier + conflicting groups -> if
+		// "font-size" is set with a postfix (text-lg/7) "leading" is removed
+		ConflictingClassGroupModifiers ConflictingClassGroups
+		// design tokens matched by the validators with a Theme namespace
+		// -> text-primary is a color, text-display a font size
+		Theme Theme
+		// css properties set by the classes of a class group, arbitrary
+		// properties conflict with the class groups setting the same
+		// properties -> [display:flex] overrides block
+		Properties ClassGroupProperties
+
+		// v4Groups is true if the class groups of v4 were added
+		v4Groups bool
+		// compiled is the table compiled on first use, see table
+		compiled *compiledTable
 	}
-	// classGroupValidator is a validator for a class group
-	classGroupValidator struct {
+	// ClassGroupValidator is a validator for a class group
+	ClassGroupValidator struct {
 		Fn           func(string) bool
 		ClassGroupID string
+		// Theme is the namespace of the theme whose tokens are matched
+		// when Fn is nil
+		Theme string
 	}
-	// classPart is a part of a class group
-	classPart struct {
-		NextPart     map[string]classPart
-		Validators   []classGroupValidator
+	// ClassPart is a part of a class group
+	ClassPart struct {
+		NextPart     map[string]*ClassPart
+		Validators   []ClassGroupValidator
 		ClassGroupID string
 	}
-	// conflictingClassGroups is a map of class groups that conflict with each other
-	conflictingClassGroups map[string][]string
+	// ConflictingClassGroups is a map of class groups that conflict with each other
+	ConflictingClassGroups map[string][]string
 )
 
-func getBreaks(groupID string) map[string]classPart {
-	return map[string]classPart{
+// isVariantPrefix reports whether the prefix is written like a variant, as
+// in Tailwind CSS v4 (tw:p-4), instead of before the utility, as in
+// Tailwind CSS v3 (tw-p-4).
+func (c *Config) isVariantPrefix() bool {
+	return c.Prefix != "" &&
+		c.Prefix[len(c.Prefix)-1] == byte(c.ModifierSeparator)
+}
+
+// trimVariantPrefix removes a v4 prefix from the class.
+//
+// It returns false if the class is not prefixed while the config requires
+// it.
+func (c *Config) trimVariantPrefix(class string) (string, bool) {
+	if !c.isVariantPrefix() {
+		return class, true
+	}
+	return strings.CutPrefix(class, c.Prefix)
+}
+
+// trimClassPrefix removes a v3 prefix from the base class, keeping the
+// negative sign in front -> -tw-m-2 becomes -m-2.
+//
+// It returns false if the class is not prefixed while the config requires
+// it.
+func (c *Config) trimClassPrefix(base string) (string, bool) {
+	if c.Prefix == "" {
+		return base, true
+	}
+	if rest, ok := strings.CutPrefix(base, c.Prefix); ok {
+		return rest, true
+	}
+	negative := string(c.ClassSeparator)
+	if rest, ok := strings.CutPrefix(base, negative+c.Prefix); ok {
+		return negative + rest, true
+	}
+	return base, false
+}
+
+// generatedPrefix returns the prefix of the generated class names.
+//
+// It makes sure that generated names like tw-0 can not be mistaken for
+// prefixed utilities.
+func (c *Config) generatedPrefix() string {
+	if c.Prefix != "" && strings.HasPrefix("tw-", c.Prefix) {
+		return "twerge-"
+	}
+	return "tw-"
+}
+
+func getBreaks(groupID string) map[string]*ClassPart {
+	return map[string]*ClassPart{
 		"auto": {
-			NextPart:     map[string]classPart{},
-			Validators:   []classGroupValidator{},
+			NextPart:     map[string]*ClassPart{},
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 		"avoid": {
-			NextPart:     make(map[string]classPart),
-			Validators:   []classGroupValidator{},
+			NextPart:     make(map[string]*ClassPart),
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 		"all": {
-			NextPart:     map[string]classPart{},
-			Validators:   []classGroupValidator{},
+			NextPart:     map[string]*ClassPart{},
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 		"page": {
-			NextPart:     map[string]classPart{},
-			Validators:   []classGroupValidator{},
+			NextPart:     map[string]*ClassPart{},
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 		"left": {
-			NextPart:     map[string]classPart{},
-			Validators:   []classGroupValidator{},
+			NextPart:     map[string]*ClassPart{},
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 		"right": {
-			NextPart:     map[string]classPart{},
-			Validators:   []classGroupValidator{},
+			NextPart:     map[string]*ClassPart{},
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 		"column": {
-			NextPart:     map[string]classPart{},
-			Validators:   []classGroupValidator{},
+			NextPart:     map[string]*ClassPart{},
+			Validators:   []ClassGroupValidator{},
 			ClassGroupID: groupID,
 		},
 	}
@@ -134,5070 +222,5364 @@ func isArbitraryShadow(val string) bool {
 	return labelIsArbitraryValue(val, "", isShadow)
 }
 func isArbitraryValue(val string) bool {
-	return arbitraryRegex.MatchString(val)
+	_, _, ok := cutArbitraryValue(val)
+	if !ok {
+		_, _, ok = cutArbitraryVariable(val)
+	}
+	return ok
 }
 func isPercent(val string) bool {
-	return val[len(val)-1] == '%' && isNumber(val[:len(val)-1])
+	number, ok := strings.CutSuffix(val, "%")
+	return ok && isNumber(number)
 }
+
+// tshirtSizes are the sizes that can follow a number in a t-shirt size.
+var tshirtSizes = [...]string{"xs", "sm", "md", "lg", "xl"}
+
 func isTshirtSize(val string) bool {
-	return shirtPattern.MatchString(val)
+	if len(val) < 2 {
+		return false
+	}
+	number, size := val[:len(val)-2], val[len(val)-2:]
+	for _, tshirtSize := range tshirtSizes {
+		if size == tshirtSize {
+			// 2xl, 1.5xl
+			whole, fraction, hasFraction := strings.Cut(number, ".")
+			return number == "" ||
+				isDigits(whole) && (!hasFraction || isDigits(fraction))
+		}
+	}
+	return false
 }
 func isShadow(val string) bool {
 	return shardowPattern.MatchString(val)
 }
+
+// imageFunctions are the css functions that return an image.
+var imageFunctions = map[string]bool{
+	"url":                       true,
+	"image":                     true,
+	"image-set":                 true,
+	"cross-fade":                true,
+	"element":                   true,
+	"linear-gradient":           true,
+	"radial-gradient":           true,
+	"conic-gradient":            true,
+	"repeating-linear-gradient": true,
+	"repeating-radial-gradient": true,
+	"repeating-conic-gradient":  true,
+}
+
 func isImage(val string) bool {
-	pattern := regexp.MustCompile(`^(url|image|image-set|cross-fade|element|(repeating-)?(linear|radial|conic)-gradient)\(.+\)$`)
-	return pattern.MatchString(val)
+	name, args, ok := strings.Cut(val, "(")
+	return ok && imageFunctions[name] && len(args) > 1 && args[len(args)-1] == ')'
 }
 func isFraction(val string) bool {
-	pattern := regexp.MustCompile(`^\d+\/\d+$`)
-	return pattern.MatchString(val)
+	numerator, denominator, ok := strings.Cut(val, "/")
+	return ok && isDigits(numerator) && isDigits(denominator)
 }
 func isNumber(val string) bool {
 	return isInteger(val) || isFloat(val)
 }
 func isInteger(val string) bool {
-	_, err := strconv.Atoi(val)
-	return err == nil
+	return isDigits(trimSign(val))
 }
 func isFloat(val string) bool {
-	_, err := strconv.ParseFloat(val, 64)
-	return err == nil
+	mantissa, exponent, hasExponent := strings.Cut(trimSign(val), "e")
+	if !hasExponent {
+		mantissa, exponent, hasExponent = strings.Cut(mantissa, "E")
+	}
+	if hasExponent && !isDigits(trimSign(exponent)) {
+		return false
+	}
+	return isUnsignedFloat(mantissa)
+}
+
+// isUnsignedFloat returns true for digits with an optional decimal point
+// -> 1, 1.5, .5 or 1.
+func isUnsignedFloat(val string) bool {
+	whole, fraction, _ := strings.Cut(val, ".")
+	if whole == "" && fraction == "" {
+		return false
+	}
+	return (whole == "" || isDigits(whole)) &&
+		(fraction == "" || isDigits(fraction))
+}
+
+// isDigits returns true if the value is one or more ascii digits.
+func isDigits(val string) bool {
+	if val == "" {
+		return false
+	}
+	for i := range len(val) {
+		if val[i] < '0' || val[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// trimSign removes a leading + or -.
+func trimSign(val string) string {
+	if val != "" && (val[0] == '-' || val[0] == '+') {
+		return val[1:]
+	}
+	return val
 }
 func isLengthOnly(val string) bool {
 	return lengthUnitRegex.MatchString(val) && !colorFnRegex.MatchString(val)
 }
 
+// cutBrackets returns the value between the opening and closing bracket
+// -> 10px of [10px].
+func cutBrackets(val string, opening, closing byte) (string, bool) {
+	if len(val) < 3 || val[0] != opening || val[len(val)-1] != closing {
+		return "", false
+	}
+	return val[1 : len(val)-1], true
+}
+
+// cutLabel splits a label from an arbitrary value -> length and 10px of
+// length:10px.
+//
+// A label is made of letters and dashes and must be followed by a value.
+func cutLabel(val string) (label, value string) {
+	for i := range len(val) {
+		char := val[i] | 0x20 // lower case
+		if ('a' <= char && char <= 'z') || val[i] == '-' {
+			continue
+		}
+		if val[i] == ':' && i > 0 && i < len(val)-1 {
+			return val[:i], val[i+1:]
+		}
+		break
+	}
+	return "", val
+}
+
+// cutArbitraryValue returns the label and the value of an arbitrary value
+// -> [length:10px].
+func cutArbitraryValue(val string) (label, value string, ok bool) {
+	inner, ok := cutBrackets(val, '[', ']')
+	if !ok {
+		return "", "", false
+	}
+	label, value = cutLabel(inner)
+	return label, value, true
+}
+
+// cutArbitraryVariable returns the label and the css variable of the v4 css
+// variable shorthand -> (length:--size).
+func cutArbitraryVariable(val string) (label, variable string, ok bool) {
+	inner, ok := cutBrackets(val, '(', ')')
+	if !ok {
+		return "", "", false
+	}
+	label, variable = cutLabel(inner)
+	if !isVariable(variable) {
+		// the label might be part of the variable -> (--a:b)
+		label, variable = "", inner
+	}
+	return label, variable, isVariable(variable)
+}
+
+// isVariable returns true for a css variable name -> --size.
+func isVariable(val string) bool {
+	return len(val) > 2 && strings.HasPrefix(val, "--")
+}
+
 // labelIsArbitraryValue returns true if the given value is an arbitrary value
 // with the given label. The label can be a string, a map[string]bool or a
 // function that takes a string and returns a bool.
+//
+// The v4 css variable shorthand -> (length:--size) is an arbitrary value of
+// var(--size).
 func labelIsArbitraryValue(
 	val string,
 	label any,
 	testValue func(string) bool,
 ) bool {
-	res := arbitraryRegex.FindStringSubmatch(val)
-	if len(res) > 1 {
-		if res[1] != "" {
-			if t, ok := label.(string); ok {
-				return res[1] == t
-			}
-			if t, ok := label.(map[string]bool); ok {
-				return t[res[1]]
-			}
+	valueLabel, value, ok := cutArbitraryValue(val)
+	if !ok {
+		valueLabel, value, ok = cutArbitraryVariable(val)
+		if !ok {
+			return false
+		}
+		value = "var(" + value + ")"
+	}
+	if valueLabel != "" {
+		if t, ok := label.(string); ok {
+			return valueLabel == t
 		}
-		if len(res) > 2 {
-			return testValue(res[2])
+		if t, ok := label.(map[string]bool); ok {
+			return t[valueLabel]
 		}
 	}
-	return false
+	return testValue(value)
 }
 
-// defaultConfig is the default TwMergeConfig
-var defaultConfig = &config{
-	ModifierSeparator: ':',
-	ClassSeparator:    '-',
-	ImportantModifier: '!',
-	PostfixModifier:   '/',
-	MaxCacheSize:      1000,
-	ConflictingClassGroups: conflictingClassGroups{
-		"overflow":   {"overflow-x", "overflow-y"},
-		"overscroll": {"overscroll-x", "overscroll-y"},
-		"inset": {
-			"inset-x",
-			"inset-y",
-			"start",
-			"end",
-			"top",
-			"right",
-			"bottom",
-			"left",
-		},
-		"inset-x": {"right", "left"},
-		"inset-y": {"top", "bottom"},
-		"flex":    {"basis", "grow", "shrink"},
-		"gap":     {"gap-x", "gap-y"},
-		"p": {
-			"px",
-			"py",
-			"ps",
-			"pe",
-			"pt",
-			"pr",
-			"pb",
-			"pl",
-		},
-		"px": {"pr", "pl"},
-		"py": {"pt", "pb"},
-		"m": {
-			"mx",
-			"my",
-			"ms",
-			"me",
-			"mt",
-			"mr",
-			"mb",
-			"ml",
-		},
-		"mx":        {"mr", "ml"},
-		"my":        {"mt", "mb"},
-		"size":      {"w", "h"},
-		"font-size": {"leading"},
-		"fvn-normal": {
-			"fvn-ordinal",
-			"fvn-slashed-zero",
-			"fvn-figure",
-			"fvn-spacing",
-			"fvn-fraction",
-		},
-		"fvn-ordinal":      {"fvn-normal"},
-		"fvn-slashed-zero": {"fvn-normal"},
-		"fvn-figure":       {"fvn-normal"},
-		"fvn-spacing":      {"fvn-normal"},
-		"fvn-fraction":     {"fvn-normal"},
-		"line-clamp":       {"display", "overflow"},
-		"rounded": {
-			"rounded-s",
-			"rounded-e",
-			"rounded-t",
-			"rounded-r",
-			"rounded-b",
-			"rounded-l",
-			"rounded-ss",
-			"rounded-se",
-			"rounded-ee",
-			"rounded-es",
-			"rounded-tl",
-			"rounded-tr",
-			"rounded-br",
-			"rounded-bl",
-		},
-		"rounded-s":      {"rounded-ss", "rounded-es"},
-		"rounded-e":      {"rounded-se", "rounded-ee"},
-		"rounded-t":      {"rounded-tl", "rounded-tr"},
-		"rounded-r":      {"rounded-tr", "rounded-br"},
-		"rounded-b":      {"rounded-br", "rounded-bl"},
-		"rounded-l":      {"rounded-tl", "rounded-bl"},
-		"border-spacing": {"border-spacing-x", "border-spacing-y"},
-		"border-w": {
-			"border-w-s",
-			"border-w-e",
-			"border-w-t",
-			"border-w-r",
-			"border-w-b",
-			"border-w-l",
+// classGroupOrder is the canonical order of the class groups, the order of
+// the Tailwind CSS documentation followed by prettier-plugin-tailwindcss:
+// layout, box model, typography, visual effects and interactivity.
+//
+// The class groups added in Tailwind CSS v4 are next to the ones they
+// relate to.
+var classGroupOrder = []string{
+	// Layout
+	"aspect", "container", "container-type", "columns", "break-after",
+	"break-before", "break-inside", "box-decoration", "box", "display", "sr",
+	"float", "clear", "isolation", "object-fit", "object-position",
+	"overflow", "overflow-x", "overflow-y", "overscroll", "overscroll-x",
+	"overscroll-y", "position", "inset", "inset-x", "inset-y", "start", "end",
+	"top", "right", "bottom", "left", "visibility", "z",
+	// Flexbox and Grid
+	"basis", "flex-direction", "flex-wrap", "flex", "grow", "shrink", "order",
+	"grid-cols", "col-start-end", "col-start", "col-end", "grid-rows",
+	"row-start-end", "row-start", "row-end", "grid-flow", "auto-cols",
+	"auto-rows", "gap", "gap-x", "gap-y", "justify-content", "justify-items",
+	"justify-self", "align-content", "align-items", "align-self",
+	"place-content", "place-items", "place-self",
+	// Spacing
+	"p", "px", "py", "ps", "pe", "pt", "pr", "pb", "pl",
+	"m", "mx", "my", "ms", "me", "mt", "mr", "mb", "ml",
+	"space-x", "space-x-reverse", "space-y", "space-y-reverse",
+	// Sizing
+	"w", "min-w", "max-w", "h", "min-h", "max-h", "size",
+	// Typography
+	"font-size", "font-smoothing", "font-style", "font-weight",
+	"font-stretch", "font-family", "fvn-normal", "fvn-ordinal",
+	"fvn-slashed-zero", "fvn-figure", "fvn-spacing", "fvn-fraction",
+	"tracking", "line-clamp", "leading", "list-image", "list-style-type",
+	"list-style-position", "placeholder-color", "placeholder-opacity",
+	"text-alignment", "text-color", "text-opacity", "text-decoration",
+	"text-decoration-style", "text-decoration-thickness", "underline-offset",
+	"text-decoration-color", "text-transform", "text-overflow", "text-wrap",
+	"indent", "vertical-align", "whitespace", "break", "wrap", "hyphens",
+	"content",
+	// Backgrounds
+	"bg-attachment", "bg-clip", "bg-opacity", "bg-origin", "bg-position",
+	"bg-repeat", "bg-size", "bg-image", "bg-color", "gradient-from-pos",
+	"gradient-via-pos", "gradient-to-pos", "gradient-from", "gradient-via",
+	"gradient-to",
+	// Borders
+	"rounded", "rounded-s", "rounded-e", "rounded-t", "rounded-r",
+	"rounded-b", "rounded-l", "rounded-ss", "rounded-se", "rounded-ee",
+	"rounded-es", "rounded-tl", "rounded-tr", "rounded-br", "rounded-bl",
+	"border-w", "border-w-x", "border-w-y", "border-w-s", "border-w-e",
+	"border-w-t", "border-w-r", "border-w-b", "border-w-l", "border-opacity",
+	"border-style", "divide-x", "divide-x-reverse", "divide-y",
+	"divide-y-reverse", "divide-opacity", "divide-style", "border-color",
+	"border-color-x", "border-color-y", "border-color-t", "border-color-r",
+	"border-color-b", "border-color-l", "divide-color", "outline-style",
+	"outline-offset", "outline-w", "outline-color", "ring-w", "ring-w-inset",
+	"ring-color", "ring-opacity", "ring-offset-w", "ring-offset-color",
+	// Effects
+	"shadow", "shadow-color", "inset-shadow", "inset-shadow-color",
+	"inset-ring-w", "inset-ring-color", "text-shadow", "text-shadow-color",
+	"opacity", "mix-blend", "bg-blend", "mask-clip", "mask-composite",
+	"mask-image", "mask-image-linear", "mask-image-linear-from",
+	"mask-image-linear-to", "mask-image-t-from", "mask-image-t-to",
+	"mask-image-r-from", "mask-image-r-to", "mask-image-b-from",
+	"mask-image-b-to", "mask-image-l-from", "mask-image-l-to",
+	"mask-image-x-from", "mask-image-x-to", "mask-image-y-from",
+	"mask-image-y-to", "mask-image-radial", "mask-image-radial-from",
+	"mask-image-radial-to", "mask-image-radial-shape",
+	"mask-image-radial-size", "mask-image-radial-pos", "mask-image-conic",
+	"mask-image-conic-from", "mask-image-conic-to", "mask-mode",
+	"mask-origin", "mask-position", "mask-repeat", "mask-size", "mask-type",
+	// Filters
+	"filter", "blur", "brightness", "contrast", "drop-shadow", "grayscale",
+	"hue-rotate", "invert", "saturate", "sepia", "backdrop-filter",
+	"backdrop-blur", "backdrop-brightness", "backdrop-contrast",
+	"backdrop-grayscale", "backdrop-hue-rotate", "backdrop-invert",
+	"backdrop-opacity", "backdrop-saturate", "backdrop-sepia",
+	// Tables
+	"border-collapse", "border-spacing", "border-spacing-x",
+	"border-spacing-y", "table-layout", "caption",
+	// Transitions and Animation
+	"transition", "duration", "ease", "delay", "animate",
+	// Transforms
+	"backface", "perspective", "perspective-origin", "transform", "rotate",
+	"rotate-x", "rotate-y", "rotate-z", "scale", "scale-x", "scale-y",
+	"scale-z", "scale-3d", "skew-x", "skew-y", "transform-origin",
+	"transform-style", "translate", "translate-x", "translate-y",
+	"translate-z",
+	// Interactivity
+	"accent", "appearance", "caret-color", "color-scheme", "cursor",
+	"field-sizing", "pointer-events", "resize", "scroll-behavior",
+	"scroll-m", "scroll-mx", "scroll-my", "scroll-ms", "scroll-me",
+	"scroll-mt", "scroll-mr", "scroll-mb", "scroll-ml", "scroll-p",
+	"scroll-px", "scroll-py", "scroll-ps", "scroll-pe", "scroll-pt",
+	"scroll-pr", "scroll-pb", "scroll-pl", "snap-align", "snap-stop",
+	"snap-type", "snap-strictness", "touch", "touch-x", "touch-y",
+	"touch-pz", "select", "will-change",
+	// SVG
+	"fill", "stroke-w", "stroke",
+	// Accessibility
+	"forced-color-adjust",
+}
+
+// defaultConfig is the default TwMergeConfig, built on first use so that
+// importing twerge does not pay for it.
+var defaultConfig = sync.OnceValue(newDefaultConfig)
+
+// newDefaultConfig returns the default TwMergeConfig.
+func newDefaultConfig() *Config {
+	return withThemeClassGroups(&Config{
+		Version:           V3,
+		ModifierSeparator: ':',
+		ClassSeparator:    '-',
+		ImportantModifier: '!',
+		PostfixModifier:   '/',
+		MaxCacheSize:      1000,
+		Variants: []string{
+			// pseudo-classes
+			"hover", "focus", "focus-within", "focus-visible", "active",
+			"visited", "target", "first", "last", "only", "odd", "even",
+			"first-of-type", "last-of-type", "only-of-type", "empty",
+			"disabled", "enabled", "checked", "indeterminate", "default",
+			"optional", "required", "valid", "invalid", "user-valid",
+			"user-invalid", "in-range", "out-of-range", "placeholder-shown",
+			"details-content", "autofill", "read-only", "read-write", "open",
+			"popover-open", "inert",
+			"nth-*", "nth-last-*", "nth-of-type-*", "nth-last-of-type-*",
+			// pseudo-elements
+			"before", "after", "first-letter", "first-line", "marker",
+			"selection", "file", "backdrop", "placeholder",
+			// media and feature queries
+			"sm", "md", "lg", "xl", "2xl", "min-*", "max-*",
+			"dark", "portrait", "landscape", "motion-safe", "motion-reduce",
+			"contrast-more", "contrast-less", "forced-colors", "print",
+			"inverted-colors", "pointer-fine", "pointer-coarse", "pointer-none",
+			"any-pointer-fine", "any-pointer-coarse", "any-pointer-none",
+			"noscript", "supports-*", "not-supports-*", "starting",
+			// container queries
+			"@3xs", "@2xs", "@xs", "@sm", "@md", "@lg", "@xl", "@2xl", "@3xl",
+			"@4xl", "@5xl", "@6xl", "@7xl", "@min-*", "@max-*",
+			// attributes and structure
+			"aria-*", "data-*", "rtl", "ltr", "group-*", "peer-*", "has-*",
+			"group", "peer", "in-*", "not-*", "*", "**",
 		},
-		"border-w-x": {"border-w-r", "border-w-l"},
-		"border-w-y": {"border-w-t", "border-w-b"},
-		"border-color": {
-			"border-color-t",
-			"border-color-r",
-			"border-color-b",
-			"border-color-l",
+		OrderSensitiveModifiers: []string{
+			"*",
+			"**",
+			"after",
+			"backdrop",
+			"before",
+			"details-content",
+			"file",
+			"first-letter",
+			"first-line",
+			"marker",
+			"placeholder",
+			"selection",
+			"has-*",
+			"in-*",
+			"not-*",
 		},
-		"border-color-x": {"border-color-r", "border-color-l"},
-		"border-color-y": {"border-color-t", "border-color-b"},
-		"scroll-m": {
-			"scroll-mx",
-			"scroll-my",
-			"scroll-ms",
-			"scroll-me",
-			"scroll-mt",
-			"scroll-mr",
-			"scroll-mb",
-			"scroll-ml",
+		ConflictingClassGroupModifiers: ConflictingClassGroups{
+			"font-size": {"leading"},
 		},
-		"scroll-mx": {"scroll-mr", "scroll-ml"},
-		"scroll-my": {"scroll-mt", "scroll-mb"},
-		"scroll-p": {
-			"scroll-px",
-			"scroll-py",
-			"scroll-ps",
-			"scroll-pe",
-			"scroll-pt",
-			"scroll-pr",
-			"scroll-pb",
-			"scroll-pl",
+		Properties: defaultProperties(),
+		ConflictingClassGroups: ConflictingClassGroups{
+			"overflow":   {"overflow-x", "overflow-y"},
+			"overscroll": {"overscroll-x", "overscroll-y"},
+			"inset": {
+				"inset-x",
+				"inset-y",
+				"start",
+				"end",
+				"top",
+				"right",
+				"bottom",
+				"left",
+			},
+			"inset-x": {"right", "left"},
+			"inset-y": {"top", "bottom"},
+			"flex":    {"basis", "grow", "shrink"},
+			"gap":     {"gap-x", "gap-y"},
+			"p": {
+				"px",
+				"py",
+				"ps",
+				"pe",
+				"pt",
+				"pr",
+				"pb",
+				"pl",
+			},
+			"px": {"pr", "pl"},
+			"py": {"pt", "pb"},
+			"m": {
+				"mx",
+				"my",
+				"ms",
+				"me",
+				"mt",
+				"mr",
+				"mb",
+				"ml",
+			},
+			"mx":        {"mr", "ml"},
+			"my":        {"mt", "mb"},
+			"size":      {"w", "h"},
+			"font-size": {"leading"},
+			"fvn-normal": {
+				"fvn-ordinal",
+				"fvn-slashed-zero",
+				"fvn-figure",
+				"fvn-spacing",
+				"fvn-fraction",
+			},
+			"fvn-ordinal":      {"fvn-normal"},
+			"fvn-slashed-zero": {"fvn-normal"},
+			"fvn-figure":       {"fvn-normal"},
+			"fvn-spacing":      {"fvn-normal"},
+			"fvn-fraction":     {"fvn-normal"},
+			"line-clamp":       {"display", "overflow"},
+			"rounded": {
+				"rounded-s",
+				"rounded-e",
+				"rounded-t",
+				"rounded-r",
+				"rounded-b",
+				"rounded-l",
+				"rounded-ss",
+				"rounded-se",
+				"rounded-ee",
+				"rounded-es",
+				"rounded-tl",
+				"rounded-tr",
+				"rounded-br",
+				"rounded-bl",
+			},
+			"rounded-s":      {"rounded-ss", "rounded-es"},
+			"rounded-e":      {"rounded-se", "rounded-ee"},
+			"rounded-t":      {"rounded-tl", "rounded-tr"},
+			"rounded-r":      {"rounded-tr", "rounded-br"},
+			"rounded-b":      {"rounded-br", "rounded-bl"},
+			"rounded-l":      {"rounded-tl", "rounded-bl"},
+			"border-spacing": {"border-spacing-x", "border-spacing-y"},
+			"border-w": {
+				"border-w-s",
+				"border-w-e",
+				"border-w-t",
+				"border-w-r",
+				"border-w-b",
+				"border-w-l",
+			},
+			"border-w-x": {"border-w-r", "border-w-l"},
+			"border-w-y": {"border-w-t", "border-w-b"},
+			"border-color": {
+				"border-color-t",
+				"border-color-r",
+				"border-color-b",
+				"border-color-l",
+			},
+			"border-color-x": {"border-color-r", "border-color-l"},
+			"border-color-y": {"border-color-t", "border-color-b"},
+			"scroll-m": {
+				"scroll-mx",
+				"scroll-my",
+				"scroll-ms",
+				"scroll-me",
+				"scroll-mt",
+				"scroll-mr",
+				"scroll-mb",
+				"scroll-ml",
+			},
+			"scroll-mx": {"scroll-mr", "scroll-ml"},
+			"scroll-my": {"scroll-mt", "scroll-mb"},
+			"scroll-p": {
+				"scroll-px",
+				"scroll-py",
+				"scroll-ps",
+				"scroll-pe",
+				"scroll-pt",
+				"scroll-pr",
+				"scroll-pb",
+				"scroll-pl",
+			},
+			"scroll-px": {"scroll-pr", "scroll-pl"},
+			"scroll-py": {"scroll-pt", "scroll-pb"},
+			"touch":     {"touch-x", "touch-y", "touch-pz"},
+			"touch-x":   {"touch"},
+			"touch-y":   {"touch"},
+			"touch-pz":  {"touch"},
 		},
-		"scroll-px": {"scroll-pr", "scroll-pl"},
-		"scroll-py": {"scroll-pt", "scroll-pb"},
-		"touch":     {"touch-x", "touch-y", "touch-pz"},
-		"touch-x":   {"touch"},
-		"touch-y":   {"touch"},
-		"touch-pz":  {"touch"},
-	},
-	ClassGroups: classPart{
-		NextPart: map[string]classPart{
-			// Aspect Ratio
-			// @see https://tailwindcss.com/docs/aspect-ratio
-			"aspect": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "aspect",
-					},
-					"square": {
-						ClassGroupID: "aspect",
-					},
-					"video": {
-						ClassGroupID: "aspect",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "aspect",
+		ClassGroups: ClassPart{
+			NextPart: map[string]*ClassPart{
+				// Aspect Ratio
+				// @see https://tailwindcss.com/docs/aspect-ratio
+				"aspect": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "aspect",
+						},
+						"square": {
+							ClassGroupID: "aspect",
+						},
+						"video": {
+							ClassGroupID: "aspect",
+						},
+					},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "aspect",
+						},
 					},
 				},
-			},
-			// Container
-			// @see https://tailwindcss.com/docs/container
-			"container": {
-				NextPart:     map[string]classPart{},
-				ClassGroupID: "container",
-			},
-			// Columns
-			// @see https://tailwindcss.com/docs/columns
-			"columns": {
-				NextPart: map[string]classPart{},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isTshirtSize,
-						ClassGroupID: "columns",
+				// Container
+				// @see https://tailwindcss.com/docs/container
+				"container": {
+					NextPart:     map[string]*ClassPart{},
+					ClassGroupID: "container",
+				},
+				// Columns
+				// @see https://tailwindcss.com/docs/columns
+				"columns": {
+					NextPart: map[string]*ClassPart{},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isTshirtSize,
+							ClassGroupID: "columns",
+						},
 					},
 				},
-			},
-			"break": {
-				NextPart: map[string]classPart{
-					// Break After
-					// @see https://tailwindcss.com/docs/break-after
-					"after": {
-						NextPart: getBreaks("break-after"),
-					},
-					// Break Before @see https://tailwindcss.com/docs/break-before
-					"before": {
-						NextPart: getBreaks("break-before"),
-					},
-					// Break Inside
-					// @see https://tailwindcss.com/docs/break-inside
-					"inside": {NextPart: map[string]classPart{
-						"auto": {
-							ClassGroupID: "break-inside",
+				"break": {
+					NextPart: map[string]*ClassPart{
+						// Break After
+						// @see https://tailwindcss.com/docs/break-after
+						"after": {
+							NextPart: getBreaks("break-after"),
 						},
-						"avoid": {
-							NextPart: map[string]classPart{
-								"page": {
-									ClassGroupID: "break-inside",
-								},
-								"column": {
-									ClassGroupID: "break-inside",
+						// Break Before @see https://tailwindcss.com/docs/break-before
+						"before": {
+							NextPart: getBreaks("break-before"),
+						},
+						// Break Inside
+						// @see https://tailwindcss.com/docs/break-inside
+						"inside": {NextPart: map[string]*ClassPart{
+							"auto": {
+								ClassGroupID: "break-inside",
+							},
+							"avoid": {
+								NextPart: map[string]*ClassPart{
+									"page": {
+										ClassGroupID: "break-inside",
+									},
+									"column": {
+										ClassGroupID: "break-inside",
+									},
 								},
+								ClassGroupID: "break-inside",
 							},
-							ClassGroupID: "break-inside",
+						},
+						},
+						// Word Break
+						// @see https://tailwindcss.com/docs/word-break
+						"normal": {
+							ClassGroupID: "break",
+						},
+						"words": {
+							ClassGroupID: "break",
+						},
+						"all": {
+							ClassGroupID: "break",
+						},
+						"keep": {
+							ClassGroupID: "break",
 						},
 					},
-					},
-					// Word Break
-					// @see https://tailwindcss.com/docs/word-break
-					"normal": {
-						ClassGroupID: "break",
-					},
-					"words": {
-						ClassGroupID: "break",
-					},
-					"all": {
-						ClassGroupID: "break",
-					},
-					"keep": {
-						ClassGroupID: "break",
-					},
+					Validators: []ClassGroupValidator{},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"box": {
-				NextPart: map[string]classPart{
-					// Box Sizing
-					// @see https://tailwindcss.com/docs/box-sizing
-					"border": {
-						ClassGroupID: "box",
-					},
-					"content": {
-						ClassGroupID: "box",
-					},
-					// Box Decoration Break
-					// @see https://tailwindcss.com/docs/box-decoration-break
-					"decoration": {
-						NextPart: map[string]classPart{
-							"slice": {
-								ClassGroupID: "box-decoration"},
-							"clone": {
-								ClassGroupID: "box-decoration",
+				"box": {
+					NextPart: map[string]*ClassPart{
+						// Box Sizing
+						// @see https://tailwindcss.com/docs/box-sizing
+						"border": {
+							ClassGroupID: "box",
+						},
+						"content": {
+							ClassGroupID: "box",
+						},
+						// Box Decoration Break
+						// @see https://tailwindcss.com/docs/box-decoration-break
+						"decoration": {
+							NextPart: map[string]*ClassPart{
+								"slice": {
+									ClassGroupID: "box-decoration"},
+								"clone": {
+									ClassGroupID: "box-decoration",
+								},
 							},
 						},
 					},
 				},
-			},
-			// Display
-			// @see https://tailwindcss.com/docs/display
-			"block": {
-				ClassGroupID: "display",
-			},
-			"inline": {
-				NextPart: map[string]classPart{
-					"block": {ClassGroupID: "display"},
-					"flex":  {ClassGroupID: "display"},
-					"grid":  {ClassGroupID: "display"},
-					"table": {ClassGroupID: "display"},
-				},
-				ClassGroupID: "display",
-			},
-			"flex": {
-				NextPart: map[string]classPart{
-					"row": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								ClassGroupID: "flex-direction",
+				// Display
+				// @see https://tailwindcss.com/docs/display
+				"block": {
+					ClassGroupID: "display",
+				},
+				"inline": {
+					NextPart: map[string]*ClassPart{
+						"block": {ClassGroupID: "display"},
+						"flex":  {ClassGroupID: "display"},
+						"grid":  {ClassGroupID: "display"},
+						"table": {ClassGroupID: "display"},
+					},
+					ClassGroupID: "display",
+				},
+				"flex": {
+					NextPart: map[string]*ClassPart{
+						"row": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									ClassGroupID: "flex-direction",
+								},
 							},
+							ClassGroupID: "flex-direction",
 						},
-						ClassGroupID: "flex-direction",
-					},
-					"col": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								ClassGroupID: "flex-direction",
+						"col": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									ClassGroupID: "flex-direction",
+								},
 							},
+							ClassGroupID: "flex-direction",
 						},
-						ClassGroupID: "flex-direction",
-					},
-					"wrap": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								ClassGroupID: "flex-wrap",
+						"wrap": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									ClassGroupID: "flex-wrap",
+								},
 							},
+							ClassGroupID: "flex-wrap",
+						},
+						"nowrap": {
+							ClassGroupID: "flex-wrap",
+						},
+						"1": {
+							ClassGroupID: "flex",
+						},
+						"auto": {
+							ClassGroupID: "flex",
+						},
+						"initial": {
+							ClassGroupID: "flex",
+						},
+						"none": {
+							ClassGroupID: "flex",
 						},
-						ClassGroupID: "flex-wrap",
-					},
-					"nowrap": {
-						ClassGroupID: "flex-wrap",
-					},
-					"1": {
-						ClassGroupID: "flex",
-					},
-					"auto": {
-						ClassGroupID: "flex",
-					},
-					"initial": {
-						ClassGroupID: "flex",
-					},
-					"none": {
-						ClassGroupID: "flex",
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "flex",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "flex",
+						},
 					},
+					ClassGroupID: "display",
 				},
-				ClassGroupID: "display",
-			},
-			"table": {
-				NextPart: map[string]classPart{
-					"caption": {
-						ClassGroupID: "display",
-					},
-					"cell": {
-						ClassGroupID: "display",
-					},
-					"column": {
-						NextPart: map[string]classPart{
-							"group": {
-								ClassGroupID: "display",
+				"table": {
+					NextPart: map[string]*ClassPart{
+						"caption": {
+							ClassGroupID: "display",
+						},
+						"cell": {
+							ClassGroupID: "display",
+						},
+						"column": {
+							NextPart: map[string]*ClassPart{
+								"group": {
+									ClassGroupID: "display",
+								},
 							},
+							ClassGroupID: "display",
 						},
-						ClassGroupID: "display",
-					},
-					"footer": {
-						NextPart: map[string]classPart{
-							"group": {
-								ClassGroupID: "display",
+						"footer": {
+							NextPart: map[string]*ClassPart{
+								"group": {
+									ClassGroupID: "display",
+								},
 							},
 						},
-					},
-					"header": {
-						NextPart: map[string]classPart{
-							"group": {
-								ClassGroupID: "display",
+						"header": {
+							NextPart: map[string]*ClassPart{
+								"group": {
+									ClassGroupID: "display",
+								},
 							},
 						},
-					},
-					"row": {
-						NextPart: map[string]classPart{
-							"group": {
-								ClassGroupID: "display",
+						"row": {
+							NextPart: map[string]*ClassPart{
+								"group": {
+									ClassGroupID: "display",
+								},
 							},
+							ClassGroupID: "display",
+						},
+						"auto": {
+							ClassGroupID: "table-layout",
+						},
+						"fixed": {
+							ClassGroupID: "table-layout",
 						},
-						ClassGroupID: "display",
-					},
-					"auto": {
-						ClassGroupID: "table-layout",
-					},
-					"fixed": {
-						ClassGroupID: "table-layout",
 					},
+					ClassGroupID: "display",
 				},
-				ClassGroupID: "display",
-			},
-			"flow": {
-				NextPart: map[string]classPart{"root": {ClassGroupID: "display"}},
-			},
-			"grid": {
-				NextPart: map[string]classPart{
-					"cols": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isAny,
-								ClassGroupID: "grid-cols",
+				"flow": {
+					NextPart: map[string]*ClassPart{"root": {ClassGroupID: "display"}},
+				},
+				"grid": {
+					NextPart: map[string]*ClassPart{
+						"cols": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isAny,
+									ClassGroupID: "grid-cols",
+								},
 							},
 						},
-					},
-					"rows": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isAny,
-								ClassGroupID: "grid-rows",
+						"rows": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isAny,
+									ClassGroupID: "grid-rows",
+								},
 							},
 						},
-					},
-					"flow": {
-						NextPart: map[string]classPart{
-							"row": {
-								NextPart: map[string]classPart{
-									"dense": {
-										ClassGroupID: "grid-flow",
+						"flow": {
+							NextPart: map[string]*ClassPart{
+								"row": {
+									NextPart: map[string]*ClassPart{
+										"dense": {
+											ClassGroupID: "grid-flow",
+										},
 									},
+									ClassGroupID: "grid-flow",
 								},
-								ClassGroupID: "grid-flow",
-							},
-							"col": {
-								NextPart: map[string]classPart{
-									"dense": {
-										ClassGroupID: "grid-flow",
+								"col": {
+									NextPart: map[string]*ClassPart{
+										"dense": {
+											ClassGroupID: "grid-flow",
+										},
 									},
+									ClassGroupID: "grid-flow",
+								},
+								"dense": {
+									ClassGroupID: "grid-flow",
 								},
-								ClassGroupID: "grid-flow",
-							},
-							"dense": {
-								ClassGroupID: "grid-flow",
 							},
 						},
 					},
+					Validators:   []ClassGroupValidator{},
+					ClassGroupID: "display",
 				},
-				Validators:   []classGroupValidator{},
-				ClassGroupID: "display",
-			},
-			"contents": {ClassGroupID: "display"},
-			"list": {
-				NextPart: map[string]classPart{
-					"item": {
-						ClassGroupID: "display",
-					},
-					"image": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "list-image",
-							},
+				"contents": {ClassGroupID: "display"},
+				"list": {
+					NextPart: map[string]*ClassPart{
+						"item": {
+							ClassGroupID: "display",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "list-image",
+						"image": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "list-image",
+								},
+							},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "list-image",
+								},
 							},
 						},
+						"none": {
+							ClassGroupID: "list-style-type",
+						},
+						"disc": {
+							ClassGroupID: "list-style-type",
+						},
+						"decimal": {
+							ClassGroupID: "list-style-type",
+						},
+						"inside": {
+							ClassGroupID: "list-style-position",
+						},
+						"outside": {
+							ClassGroupID: "list-style-position",
+						},
 					},
-					"none": {
-						ClassGroupID: "list-style-type",
-					},
-					"disc": {
-						ClassGroupID: "list-style-type",
-					},
-					"decimal": {
-						ClassGroupID: "list-style-type",
-					},
-					"inside": {
-						ClassGroupID: "list-style-position",
-					},
-					"outside": {
-						ClassGroupID: "list-style-position",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn: func(s string) bool {
-							return s == "none" || s == "disc" || s == "decimal" || s == "square" || s == "circle"
+					Validators: []ClassGroupValidator{
+						{
+							Fn: func(s string) bool {
+								return s == "none" || s == "disc" || s == "decimal" || s == "square" || s == "circle"
+							},
+							ClassGroupID: "list-style-type",
 						},
-						ClassGroupID: "list-style-type",
 					},
 				},
-			},
-			"hidden": {ClassGroupID: "display"},
-			"float": {
-				NextPart: map[string]classPart{
-					"right": {
-						ClassGroupID: "float",
-					},
-					"left": {
-						ClassGroupID: "float",
-					},
-					"none": {
-						ClassGroupID: "float",
-					},
-					"start": {
-						ClassGroupID: "float",
-					},
-					"end": {
-						ClassGroupID: "float",
+				"hidden": {ClassGroupID: "display"},
+				"float": {
+					NextPart: map[string]*ClassPart{
+						"right": {
+							ClassGroupID: "float",
+						},
+						"left": {
+							ClassGroupID: "float",
+						},
+						"none": {
+							ClassGroupID: "float",
+						},
+						"start": {
+							ClassGroupID: "float",
+						},
+						"end": {
+							ClassGroupID: "float",
+						},
 					},
 				},
-			},
-			"clear": {
-				NextPart: map[string]classPart{
-					"left": {
-						ClassGroupID: "clear",
-					},
-					"right": {
-						ClassGroupID: "clear",
-					},
-					"both": {
-						ClassGroupID: "clear",
-					},
-					"none": {
-						ClassGroupID: "clear",
-					},
-					"start": {
-						ClassGroupID: "clear",
-					},
-					"end": {
-						ClassGroupID: "clear",
+				"clear": {
+					NextPart: map[string]*ClassPart{
+						"left": {
+							ClassGroupID: "clear",
+						},
+						"right": {
+							ClassGroupID: "clear",
+						},
+						"both": {
+							ClassGroupID: "clear",
+						},
+						"none": {
+							ClassGroupID: "clear",
+						},
+						"start": {
+							ClassGroupID: "clear",
+						},
+						"end": {
+							ClassGroupID: "clear",
+						},
 					},
 				},
-			},
-			"isolate": {ClassGroupID: "isolation"},
-			"isolation": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "isolation",
+				"isolate": {ClassGroupID: "isolation"},
+				"isolation": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "isolation",
+						},
 					},
 				},
-			},
-			"object": {
-				NextPart: map[string]classPart{
-					"contain": {
-						ClassGroupID: "object-fit",
-					},
-					"cover": {
-						ClassGroupID: "object-fit",
-					},
-					"fill": {
-						ClassGroupID: "object-fit",
-					},
-					"none": {
-						ClassGroupID: "object-fit",
-					},
-					"scale": {
-						NextPart: map[string]classPart{
-							"down": {
-								ClassGroupID: "object-fit",
+				"object": {
+					NextPart: map[string]*ClassPart{
+						"contain": {
+							ClassGroupID: "object-fit",
+						},
+						"cover": {
+							ClassGroupID: "object-fit",
+						},
+						"fill": {
+							ClassGroupID: "object-fit",
+						},
+						"none": {
+							ClassGroupID: "object-fit",
+						},
+						"scale": {
+							NextPart: map[string]*ClassPart{
+								"down": {
+									ClassGroupID: "object-fit",
+								},
 							},
 						},
+						"bottom": {
+							ClassGroupID: "object-position",
+						},
+						"center": {
+							ClassGroupID: "object-position",
+						},
+						"left": {
+							NextPart: map[string]*ClassPart{
+								"bottom": {
+									ClassGroupID: "object-position",
+								},
+								"top": {
+									ClassGroupID: "object-position",
+								},
+							},
+						},
+						"right": {
+							NextPart: map[string]*ClassPart{
+								"bottom": {
+									ClassGroupID: "object-position",
+								},
+								"top": {
+									ClassGroupID: "object-position",
+								},
+							},
+						},
+						"top": {
+							ClassGroupID: "object-position",
+						},
 					},
-					"bottom": {
-						ClassGroupID: "object-position",
-					},
-					"center": {
-						ClassGroupID: "object-position",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "object-position",
+						},
 					},
-					"left": {
-						NextPart: map[string]classPart{
-							"bottom": {
-								ClassGroupID: "object-position",
+				},
+				"overflow": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "overflow",
+						},
+						"hidden": {
+							ClassGroupID: "overflow",
+						},
+						"clip": {
+							ClassGroupID: "overflow",
+						},
+						"visible": {
+							ClassGroupID: "overflow",
+						},
+						"scroll": {
+							ClassGroupID: "overflow",
+						},
+						"x": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "overflow-x",
+								},
+								"hidden": {
+									ClassGroupID: "overflow-x",
+								},
+								"clip": {
+									ClassGroupID: "overflow-x",
+								},
+								"visible": {
+									ClassGroupID: "overflow-x",
+								},
+								"scroll": {
+									ClassGroupID: "overflow-x",
+								},
 							},
-							"top": {
-								ClassGroupID: "object-position",
+						},
+						"y": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "overflow-y",
+								},
+								"hidden": {
+									ClassGroupID: "overflow-y",
+								},
+								"clip": {
+									ClassGroupID: "overflow-y",
+								},
+								"visible": {
+									ClassGroupID: "overflow-y",
+								},
+								"scroll": {
+									ClassGroupID: "overflow-y",
+								},
 							},
 						},
 					},
-					"right": {
-						NextPart: map[string]classPart{
-							"bottom": {
-								ClassGroupID: "object-position",
+				},
+				"overscroll": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "overscroll",
+						},
+						"contain": {
+							ClassGroupID: "overscroll",
+						},
+						"none": {
+							ClassGroupID: "overscroll",
+						},
+						"x": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "overscroll-x",
+								},
+								"contain": {
+									ClassGroupID: "overscroll-x",
+								},
+								"none": {
+									ClassGroupID: "overscroll-x",
+								},
 							},
-							"top": {
-								ClassGroupID: "object-position",
+						},
+						"y": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "overscroll-y",
+								},
+								"contain": {
+									ClassGroupID: "overscroll-y",
+								},
+								"none": {
+									ClassGroupID: "overscroll-y",
+								},
 							},
 						},
 					},
-					"top": {
-						ClassGroupID: "object-position",
-					},
+					Validators: []ClassGroupValidator{},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "object-position",
-					},
+				"static": {
+					ClassGroupID: "position",
 				},
-			},
-			"overflow": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "overflow",
-					},
-					"hidden": {
-						ClassGroupID: "overflow",
-					},
-					"clip": {
-						ClassGroupID: "overflow",
-					},
-					"visible": {
-						ClassGroupID: "overflow",
-					},
-					"scroll": {
-						ClassGroupID: "overflow",
-					},
-					"x": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "overflow-x",
-							},
-							"hidden": {
-								ClassGroupID: "overflow-x",
-							},
-							"clip": {
-								ClassGroupID: "overflow-x",
-							},
-							"visible": {
-								ClassGroupID: "overflow-x",
-							},
-							"scroll": {
-								ClassGroupID: "overflow-x",
-							},
+				"fixed": {
+					ClassGroupID: "position",
+				},
+				"absolute": {
+					ClassGroupID: "position",
+				},
+				"relative": {
+					ClassGroupID: "position",
+				},
+				"sticky": {
+					ClassGroupID: "position",
+				},
+				"inset": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "inset",
 						},
-					},
-					"y": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "overflow-y",
-							},
-							"hidden": {
-								ClassGroupID: "overflow-y",
-							},
-							"clip": {
-								ClassGroupID: "overflow-y",
-							},
-							"visible": {
-								ClassGroupID: "overflow-y",
+						"x": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "inset-x",
+								},
 							},
-							"scroll": {
-								ClassGroupID: "overflow-y",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "inset-x",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "inset-x",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "inset-x",
+								},
 							},
 						},
-					},
-				},
-			},
-			"overscroll": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "overscroll",
-					},
-					"contain": {
-						ClassGroupID: "overscroll",
-					},
-					"none": {
-						ClassGroupID: "overscroll",
-					},
-					"x": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "overscroll-x",
-							},
-							"contain": {
-								ClassGroupID: "overscroll-x",
+						"y": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "inset-y",
+								},
 							},
-							"none": {
-								ClassGroupID: "overscroll-x",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "inset-y",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "inset-y",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "inset-y",
+								},
 							},
 						},
 					},
-					"y": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "overscroll-y",
-							},
-							"contain": {
-								ClassGroupID: "overscroll-y",
-							},
-							"none": {
-								ClassGroupID: "overscroll-y",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "inset",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "inset",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "inset",
 						},
 					},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"static": {
-				ClassGroupID: "position",
-			},
-			"fixed": {
-				ClassGroupID: "position",
-			},
-			"absolute": {
-				ClassGroupID: "position",
-			},
-			"relative": {
-				ClassGroupID: "position",
-			},
-			"sticky": {
-				ClassGroupID: "position",
-			},
-			"inset": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "inset",
-					},
-					"x": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "inset-x",
-							},
-						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "inset-x",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "inset-x",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "inset-x",
-							},
+				"start": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "start",
 						},
 					},
-					"y": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "inset-y",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "start",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "inset-y",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "inset-y",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "inset-y",
-							},
+						{
+							Fn:           isLength,
+							ClassGroupID: "start",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "start",
 						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "inset",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "inset",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "inset",
-					},
-				},
-			},
-			"start": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "start",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "start",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "start",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "start",
-					},
-				},
-			},
-			"end": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "end",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "end",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "end",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "end",
+				"end": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "end",
+						},
 					},
-				},
-			},
-			"top": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "top",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "end",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "end",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "end",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "top",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "top",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "top",
+				"top": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "top",
+						},
 					},
-				},
-			},
-			"right": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "right",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "top",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "top",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "top",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "right",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "right",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "right",
+				"right": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "right",
+						},
 					},
-				},
-			},
-			"bottom": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "bottom",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "right",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "right",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "right",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "bottom",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "bottom",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "bottom",
+				"bottom": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "bottom",
+						},
 					},
-				},
-			},
-			"left": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "left",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "bottom",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "bottom",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "bottom",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "left",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "left",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "left",
+				"left": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "left",
+						},
 					},
-				},
-			},
-			"visible": {
-				ClassGroupID: "visibility",
-			},
-			"invisible": {
-				ClassGroupID: "visibility",
-			},
-			"collapse": {
-				ClassGroupID: "visibility",
-			},
-			"z": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "z",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "left",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "left",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "left",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isInteger,
-						ClassGroupID: "z",
-					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "z",
-					},
+				"visible": {
+					ClassGroupID: "visibility",
 				},
-			},
-			"basis": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "basis",
-					},
+				"invisible": {
+					ClassGroupID: "visibility",
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "basis",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "basis",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "basis",
-					},
+				"collapse": {
+					ClassGroupID: "visibility",
 				},
-			},
-			"grow": {
-				NextPart: map[string]classPart{
-					"0": {
-						ClassGroupID: "grow",
+				"z": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "z",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "grow",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isInteger,
+							ClassGroupID: "z",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "z",
+						},
 					},
 				},
-				ClassGroupID: "grow",
-			},
-			"shrink": {
-				NextPart: map[string]classPart{
-					"0": {
-						ClassGroupID: "shrink",
+				"basis": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "basis",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "shrink",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "basis",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "basis",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "basis",
+						},
 					},
 				},
-				ClassGroupID: "shrink",
-			},
-			"order": {
-				NextPart: map[string]classPart{
-					"first": {
-						ClassGroupID: "order",
-					},
-					"last": {
-						ClassGroupID: "order",
+				"grow": {
+					NextPart: map[string]*ClassPart{
+						"0": {
+							ClassGroupID: "grow",
+						},
 					},
-					"none": {
-						ClassGroupID: "order",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "grow",
+						},
 					},
+					ClassGroupID: "grow",
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isInteger,
-						ClassGroupID: "order",
+				"shrink": {
+					NextPart: map[string]*ClassPart{
+						"0": {
+							ClassGroupID: "shrink",
+						},
 					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "order",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "shrink",
+						},
 					},
+					ClassGroupID: "shrink",
 				},
-			},
-			"col": {
-				NextPart: map[string]classPart{
-					"auto": {
-						NextPart:     map[string]classPart{},
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "col-start-end",
-					},
-					"span": {
-						NextPart: map[string]classPart{
-							"full": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "col-start-end",
-							},
+				"order": {
+					NextPart: map[string]*ClassPart{
+						"first": {
+							ClassGroupID: "order",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isInteger,
-								ClassGroupID: "col-start-end",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "col-start-end",
-							},
+						"last": {
+							ClassGroupID: "order",
+						},
+						"none": {
+							ClassGroupID: "order",
 						},
 					},
-					"start": {
-						NextPart: map[string]classPart{
-							"auto": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "col-start",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isInteger,
+							ClassGroupID: "order",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "order",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "col-start",
+					},
+				},
+				"col": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							NextPart:     map[string]*ClassPart{},
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "col-start-end",
+						},
+						"span": {
+							NextPart: map[string]*ClassPart{
+								"full": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "col-start-end",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "col-start",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isInteger,
+									ClassGroupID: "col-start-end",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "col-start-end",
+								},
 							},
 						},
-					},
-					"end": {
-						NextPart: map[string]classPart{
-							"auto": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "col-end",
+						"start": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "col-start",
+								},
+							},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "col-start",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "col-start",
+								},
 							},
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "col-end",
+						"end": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "col-end",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "col-end",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "col-end",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "col-end",
+								},
 							},
 						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "col-start-end",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "col-start-end",
+						},
 					},
 				},
-			},
-			"row": {
-				NextPart: map[string]classPart{
-					"auto": {
-						NextPart:     map[string]classPart{},
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "row-start-end",
-					},
-					"span": {
-						NextPart: map[string]classPart{},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isInteger,
-								ClassGroupID: "row-start-end",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "row-start-end",
+				"row": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							NextPart:     map[string]*ClassPart{},
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "row-start-end",
+						},
+						"span": {
+							NextPart: map[string]*ClassPart{},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isInteger,
+									ClassGroupID: "row-start-end",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "row-start-end",
+								},
 							},
 						},
-					},
-					"start": {
-						NextPart: map[string]classPart{
-							"auto": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "row-start",
+						"start": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "row-start",
+								},
+							},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "row-start",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "row-start",
+								},
 							},
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "row-start",
+						"end": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "row-end",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "row-start",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "row-end",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "row-end",
+								},
 							},
 						},
 					},
-					"end": {
-						NextPart: map[string]classPart{
-							"auto": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "row-end",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "row-start-end",
+						},
+					},
+				},
+				"auto": {
+					NextPart: map[string]*ClassPart{
+						"cols": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-cols",
+								},
+								"min": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-cols",
+								},
+								"max": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-cols",
+								},
+								"fr": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-cols",
+								},
+							},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "auto-cols",
+								},
 							},
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "row-end",
+						"rows": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-rows",
+								},
+								"min": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-rows",
+								},
+								"max": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-rows",
+								},
+								"fr": {
+									NextPart:     map[string]*ClassPart{},
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "auto-rows",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "row-end",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "auto-rows",
+								},
 							},
 						},
 					},
+					Validators: []ClassGroupValidator{},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "row-start-end",
-					},
-				},
-			},
-			"auto": {
-				NextPart: map[string]classPart{
-					"cols": {
-						NextPart: map[string]classPart{
-							"auto": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-cols",
-							},
-							"min": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-cols",
-							},
-							"max": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-cols",
-							},
-							"fr": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-cols",
+				"gap": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "gap-x",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "gap-x",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "gap-x",
+								},
 							},
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "auto-cols",
+						"y": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "gap-y",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "gap-y",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "gap-y",
+								},
 							},
 						},
 					},
-					"rows": {
-						NextPart: map[string]classPart{
-							"auto": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-rows",
-							},
-							"min": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-rows",
-							},
-							"max": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-rows",
-							},
-							"fr": {
-								NextPart:     map[string]classPart{},
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "auto-rows",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "gap",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "auto-rows",
-							},
+						{
+							Fn:           isLength,
+							ClassGroupID: "gap",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "gap",
 						},
 					},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"gap": {
-				NextPart: map[string]classPart{
-					"x": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "gap-x",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "gap-x",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "gap-x",
-							},
+				"justify": {
+					NextPart: map[string]*ClassPart{
+						"normal": {
+							ClassGroupID: "justify-content",
 						},
-					},
-					"y": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "gap-y",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "gap-y",
+						"start": {
+							ClassGroupID: "justify-content",
+						},
+						"end": {
+							ClassGroupID: "justify-content",
+						},
+						"center": {
+							ClassGroupID: "justify-content",
+						},
+						"between": {
+							ClassGroupID: "justify-content",
+						},
+						"around": {
+							ClassGroupID: "justify-content",
+						},
+						"evenly": {
+							ClassGroupID: "justify-content",
+						},
+						"stretch": {
+							ClassGroupID: "justify-content",
+						},
+						"items": {
+							NextPart: map[string]*ClassPart{
+								"start": {
+									ClassGroupID: "justify-items",
+								},
+								"end": {
+									ClassGroupID: "justify-items",
+								},
+								"center": {
+									ClassGroupID: "justify-items",
+								},
+								"stretch": {
+									ClassGroupID: "justify-items",
+								},
 							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "gap-y",
+						},
+						"self": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "justify-self",
+								},
+								"start": {
+									ClassGroupID: "justify-self",
+								},
+								"end": {
+									ClassGroupID: "justify-self",
+								},
+								"center": {
+									ClassGroupID: "justify-self",
+								},
+								"stretch": {
+									ClassGroupID: "justify-self",
+								},
 							},
 						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "gap",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "gap",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "gap",
-					},
-				},
-			},
-			"justify": {
-				NextPart: map[string]classPart{
-					"normal": {
-						ClassGroupID: "justify-content",
-					},
-					"start": {
-						ClassGroupID: "justify-content",
-					},
-					"end": {
-						ClassGroupID: "justify-content",
-					},
-					"center": {
-						ClassGroupID: "justify-content",
-					},
-					"between": {
-						ClassGroupID: "justify-content",
-					},
-					"around": {
-						ClassGroupID: "justify-content",
-					},
-					"evenly": {
-						ClassGroupID: "justify-content",
-					},
-					"stretch": {
-						ClassGroupID: "justify-content",
-					},
-					"items": {
-						NextPart: map[string]classPart{
-							"start": {
-								ClassGroupID: "justify-items",
-							},
-							"end": {
-								ClassGroupID: "justify-items",
-							},
-							"center": {
-								ClassGroupID: "justify-items",
-							},
-							"stretch": {
-								ClassGroupID: "justify-items",
-							},
+				"content": {
+					NextPart: map[string]*ClassPart{
+						"normal": {
+							ClassGroupID: "align-content",
 						},
-					},
-					"self": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "justify-self",
-							},
-							"start": {
-								ClassGroupID: "justify-self",
-							},
-							"end": {
-								ClassGroupID: "justify-self",
-							},
-							"center": {
-								ClassGroupID: "justify-self",
-							},
-							"stretch": {
-								ClassGroupID: "justify-self",
-							},
+						"start": {
+							ClassGroupID: "align-content",
+						},
+						"end": {
+							ClassGroupID: "align-content",
+						},
+						"center": {
+							ClassGroupID: "align-content",
+						},
+						"between": {
+							ClassGroupID: "align-content",
+						},
+						"around": {
+							ClassGroupID: "align-content",
+						},
+						"evenly": {
+							ClassGroupID: "align-content",
+						},
+						"stretch": {
+							ClassGroupID: "align-content",
+						},
+						"baseline": {
+							ClassGroupID: "align-content",
+						},
+						"none": {
+							ClassGroupID: "content",
 						},
 					},
-				},
-			},
-			"content": {
-				NextPart: map[string]classPart{
-					"normal": {
-						ClassGroupID: "align-content",
-					},
-					"start": {
-						ClassGroupID: "align-content",
-					},
-					"end": {
-						ClassGroupID: "align-content",
-					},
-					"center": {
-						ClassGroupID: "align-content",
-					},
-					"between": {
-						ClassGroupID: "align-content",
-					},
-					"around": {
-						ClassGroupID: "align-content",
-					},
-					"evenly": {
-						ClassGroupID: "align-content",
-					},
-					"stretch": {
-						ClassGroupID: "align-content",
-					},
-					"baseline": {
-						ClassGroupID: "align-content",
-					},
-					"none": {
-						ClassGroupID: "content",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "content",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "content",
+						},
 					},
 				},
-			},
-			"items": {
-				NextPart: map[string]classPart{
-					"start": {
-						ClassGroupID: "align-items",
-					},
-					"end": {
-						ClassGroupID: "align-items",
-					},
-					"center": {
-						ClassGroupID: "align-items",
-					},
-					"baseline": {
-						ClassGroupID: "align-items",
-					},
-					"stretch": {
-						ClassGroupID: "align-items",
+				"items": {
+					NextPart: map[string]*ClassPart{
+						"start": {
+							ClassGroupID: "align-items",
+						},
+						"end": {
+							ClassGroupID: "align-items",
+						},
+						"center": {
+							ClassGroupID: "align-items",
+						},
+						"baseline": {
+							ClassGroupID: "align-items",
+						},
+						"stretch": {
+							ClassGroupID: "align-items",
+						},
 					},
 				},
-			},
-			"self": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "align-self",
-					},
-					"start": {
-						ClassGroupID: "align-self",
-					},
-					"end": {
-						ClassGroupID: "align-self",
-					},
-					"center": {
-						ClassGroupID: "align-self",
-					},
-					"stretch": {
-						ClassGroupID: "align-self",
-					},
-					"baseline": {
-						ClassGroupID: "align-self",
+				"self": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "align-self",
+						},
+						"start": {
+							ClassGroupID: "align-self",
+						},
+						"end": {
+							ClassGroupID: "align-self",
+						},
+						"center": {
+							ClassGroupID: "align-self",
+						},
+						"stretch": {
+							ClassGroupID: "align-self",
+						},
+						"baseline": {
+							ClassGroupID: "align-self",
+						},
 					},
 				},
-			},
-			"place": {
-				NextPart: map[string]classPart{
-					"content": {
-						NextPart: map[string]classPart{
-							"start": {
-								ClassGroupID: "place-content",
-							},
-							"end": {
-								ClassGroupID: "place-content",
-							},
-							"center": {
-								ClassGroupID: "place-content",
-							},
-							"between": {
-								ClassGroupID: "place-content",
-							},
-							"around": {
-								ClassGroupID: "place-content",
-							},
-							"evenly": {
-								ClassGroupID: "place-content",
-							},
-							"stretch": {
-								ClassGroupID: "place-content",
-							},
-							"baseline": {
-								ClassGroupID: "place-content",
+				"place": {
+					NextPart: map[string]*ClassPart{
+						"content": {
+							NextPart: map[string]*ClassPart{
+								"start": {
+									ClassGroupID: "place-content",
+								},
+								"end": {
+									ClassGroupID: "place-content",
+								},
+								"center": {
+									ClassGroupID: "place-content",
+								},
+								"between": {
+									ClassGroupID: "place-content",
+								},
+								"around": {
+									ClassGroupID: "place-content",
+								},
+								"evenly": {
+									ClassGroupID: "place-content",
+								},
+								"stretch": {
+									ClassGroupID: "place-content",
+								},
+								"baseline": {
+									ClassGroupID: "place-content",
+								},
 							},
 						},
-					},
-					"items": {
-						NextPart: map[string]classPart{
-							"start": {
-								ClassGroupID: "place-items",
-							},
-							"end": {
-								ClassGroupID: "place-items",
-							},
-							"center": {
-								ClassGroupID: "place-items",
-							},
-							"baseline": {
-								ClassGroupID: "place-items",
-							},
-							"stretch": {
-								ClassGroupID: "place-items",
+						"items": {
+							NextPart: map[string]*ClassPart{
+								"start": {
+									ClassGroupID: "place-items",
+								},
+								"end": {
+									ClassGroupID: "place-items",
+								},
+								"center": {
+									ClassGroupID: "place-items",
+								},
+								"baseline": {
+									ClassGroupID: "place-items",
+								},
+								"stretch": {
+									ClassGroupID: "place-items",
+								},
 							},
 						},
-					},
-					"self": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "place-self",
-							},
-							"start": {
-								ClassGroupID: "place-self",
-							},
-							"end": {
-								ClassGroupID: "place-self",
-							},
-							"center": {
-								ClassGroupID: "place-self",
-							},
-							"stretch": {
-								ClassGroupID: "place-self",
+						"self": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "place-self",
+								},
+								"start": {
+									ClassGroupID: "place-self",
+								},
+								"end": {
+									ClassGroupID: "place-self",
+								},
+								"center": {
+									ClassGroupID: "place-self",
+								},
+								"stretch": {
+									ClassGroupID: "place-self",
+								},
 							},
 						},
 					},
 				},
-			},
-			"p": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "p",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "p",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "p",
-					},
-				},
-			},
-			"px": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "px",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "px",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "px",
+				"p": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "p",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "p",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "p",
+						},
 					},
 				},
-			},
-			"py": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "py",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "py",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "py",
+				"px": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "px",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "px",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "px",
+						},
 					},
 				},
-			},
-			"ps": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "ps",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "ps",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "ps",
+				"py": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "py",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "py",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "py",
+						},
 					},
 				},
-			},
-			"pe": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "pe",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "pe",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "pe",
+				"ps": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "ps",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "ps",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "ps",
+						},
 					},
 				},
-			},
-			"pt": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "pt",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "pt",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "pt",
+				"pe": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "pe",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "pe",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "pe",
+						},
 					},
 				},
-			},
-			"pr": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "pr",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "pr",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "pr",
+				"pt": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "pt",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "pt",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "pt",
+						},
 					},
 				},
-			},
-			"pb": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "pb",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "pb",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "pb",
+				"pr": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "pr",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "pr",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "pr",
+						},
 					},
 				},
-			},
-			"pl": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "pl",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "pl",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "pl",
+				"pb": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "pb",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "pb",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "pb",
+						},
 					},
 				},
-			},
-			"m": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "m",
+				"pl": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "pl",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "pl",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "pl",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "m",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "m",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "m",
+				"m": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "m",
+						},
 					},
-				},
-			},
-			"mx": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "mx",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "m",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "m",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "m",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "mx",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "mx",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "mx",
+				"mx": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "mx",
+						},
 					},
-				},
-			},
-			"my": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "my",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "mx",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "mx",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "mx",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "my",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "my",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "my",
+				"my": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "my",
+						},
 					},
-				},
-			},
-			"ms": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "ms",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "my",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "my",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "my",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "ms",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "ms",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "ms",
+				"ms": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "ms",
+						},
 					},
-				},
-			},
-			"me": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "me",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "ms",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "ms",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "ms",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "me",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "me",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "me",
+				"me": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "me",
+						},
 					},
-				},
-			},
-			"mt": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "mt",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "me",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "me",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "me",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "mt",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "mt",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "mt",
+				"mt": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "mt",
+						},
 					},
-				},
-			},
-			"mr": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "mr",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "mt",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "mt",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "mt",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "mr",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "mr",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "mr",
+				"mr": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "mr",
+						},
 					},
-				},
-			},
-			"mb": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "mb",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "mr",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "mr",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "mr",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "mb",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "mb",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "mb",
+				"mb": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "mb",
+						},
 					},
-				},
-			},
-			"ml": {
-				NextPart: map[string]classPart{
-					"auto": {
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "ml",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "mb",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "mb",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "mb",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "ml",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "ml",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "ml",
+				"ml": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "ml",
+						},
 					},
-				},
-			},
-			"space": {
-				NextPart: map[string]classPart{
-					"x": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								Validators:   []classGroupValidator{},
-								ClassGroupID: "space-x-reverse",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "ml",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "space-x",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "space-x",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "space-x",
-							},
+						{
+							Fn:           isLength,
+							ClassGroupID: "ml",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "ml",
 						},
 					},
-					"y": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								ClassGroupID: "space-y-reverse",
+				},
+				"space": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									Validators:   []ClassGroupValidator{},
+									ClassGroupID: "space-x-reverse",
+								},
 							},
-						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "space-y",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "space-x",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "space-x",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "space-x",
+								},
 							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "space-y",
+						},
+						"y": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									ClassGroupID: "space-y-reverse",
+								},
 							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "space-y",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "space-y",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "space-y",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "space-y",
+								},
 							},
 						},
 					},
+					Validators: []ClassGroupValidator{},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"w": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "w",
-					},
-					"min": {
-						ClassGroupID: "w",
-					},
-					"max": {
-						ClassGroupID: "w",
-					},
-					"fit": {
-						ClassGroupID: "w",
-					},
-					"svw": {
-						ClassGroupID: "w",
-					},
-					"lvw": {
-						ClassGroupID: "w",
-					},
-					"dvw": {
-						ClassGroupID: "w",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "w",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "w",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "w",
-					},
-				},
-			},
-			"min": {
-				NextPart: map[string]classPart{
-					"w": {
-						NextPart: map[string]classPart{
-							"min": {
-								ClassGroupID: "min-w",
-							},
-							"max": {
-								ClassGroupID: "min-w",
-							},
-							"fit": {
-								ClassGroupID: "min-w",
-							},
+				"w": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "w",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "min-w",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "min-w",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "min-w",
-							},
+						"min": {
+							ClassGroupID: "w",
+						},
+						"max": {
+							ClassGroupID: "w",
+						},
+						"fit": {
+							ClassGroupID: "w",
+						},
+						"svw": {
+							ClassGroupID: "w",
+						},
+						"lvw": {
+							ClassGroupID: "w",
+						},
+						"dvw": {
+							ClassGroupID: "w",
 						},
 					},
-					"h": {
-						NextPart: map[string]classPart{
-							"min": {
-								ClassGroupID: "min-h",
-							},
-							"max": {
-								ClassGroupID: "min-h",
-							},
-							"fit": {
-								ClassGroupID: "min-h",
-							},
-							"svh": {
-								ClassGroupID: "min-h",
-							},
-							"lvh": {
-								ClassGroupID: "min-h",
-							},
-							"dvh": {
-								ClassGroupID: "min-h",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "w",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "min-h",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "min-h",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "min-h",
-							},
+						{
+							Fn:           isLength,
+							ClassGroupID: "w",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "w",
 						},
 					},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"max": {
-				NextPart: map[string]classPart{
-					"w": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "max-w",
-							},
-							"full": {
-								ClassGroupID: "max-w",
-							},
-							"min": {
-								ClassGroupID: "max-w",
-							},
-							"max": {
-								ClassGroupID: "max-w",
-							},
-							"fit": {
-								ClassGroupID: "max-w",
-							},
-							"prose": {
-								ClassGroupID: "max-w",
+				"min": {
+					NextPart: map[string]*ClassPart{
+						"w": {
+							NextPart: map[string]*ClassPart{
+								"min": {
+									ClassGroupID: "min-w",
+								},
+								"max": {
+									ClassGroupID: "min-w",
+								},
+								"fit": {
+									ClassGroupID: "min-w",
+								},
 							},
-							"screen": {
-								Validators: []classGroupValidator{
-									{
-										Fn:           isTshirtSize,
-										ClassGroupID: "max-w",
-									},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "min-w",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "min-w",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "min-w",
 								},
-								ClassGroupID: "max-w",
 							},
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "max-w",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "max-w",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "max-w",
+						"h": {
+							NextPart: map[string]*ClassPart{
+								"min": {
+									ClassGroupID: "min-h",
+								},
+								"max": {
+									ClassGroupID: "min-h",
+								},
+								"fit": {
+									ClassGroupID: "min-h",
+								},
+								"svh": {
+									ClassGroupID: "min-h",
+								},
+								"lvh": {
+									ClassGroupID: "min-h",
+								},
+								"dvh": {
+									ClassGroupID: "min-h",
+								},
 							},
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "max-w",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "min-h",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "min-h",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "min-h",
+								},
 							},
 						},
-						ClassGroupID: "max-w",
 					},
-					"h": {
-						NextPart: map[string]classPart{
-							"min": {
-								ClassGroupID: "max-h",
-							},
-							"max": {
-								ClassGroupID: "max-h",
-							},
-							"fit": {
-								ClassGroupID: "max-h",
-							},
-							"svh": {
-								ClassGroupID: "max-h",
-							},
-							"lvh": {
-								ClassGroupID: "max-h",
+					Validators: []ClassGroupValidator{},
+				},
+				"max": {
+					NextPart: map[string]*ClassPart{
+						"w": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "max-w",
+								},
+								"full": {
+									ClassGroupID: "max-w",
+								},
+								"min": {
+									ClassGroupID: "max-w",
+								},
+								"max": {
+									ClassGroupID: "max-w",
+								},
+								"fit": {
+									ClassGroupID: "max-w",
+								},
+								"prose": {
+									ClassGroupID: "max-w",
+								},
+								"screen": {
+									Validators: []ClassGroupValidator{
+										{
+											Fn:           isTshirtSize,
+											ClassGroupID: "max-w",
+										},
+									},
+									ClassGroupID: "max-w",
+								},
 							},
-							"dvh": {
-								ClassGroupID: "max-h",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "max-w",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "max-w",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "max-w",
+								},
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "max-w",
+								},
 							},
+							ClassGroupID: "max-w",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "max-h",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "max-h",
+						"h": {
+							NextPart: map[string]*ClassPart{
+								"min": {
+									ClassGroupID: "max-h",
+								},
+								"max": {
+									ClassGroupID: "max-h",
+								},
+								"fit": {
+									ClassGroupID: "max-h",
+								},
+								"svh": {
+									ClassGroupID: "max-h",
+								},
+								"lvh": {
+									ClassGroupID: "max-h",
+								},
+								"dvh": {
+									ClassGroupID: "max-h",
+								},
 							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "max-h",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "max-h",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "max-h",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "max-h",
+								},
 							},
+							ClassGroupID: "max-h",
 						},
-						ClassGroupID: "max-h",
-					},
-				},
-			},
-			"h": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "h",
-					},
-					"min": {
-						ClassGroupID: "h",
-					},
-					"max": {
-						ClassGroupID: "h",
-					},
-					"fit": {
-						ClassGroupID: "h",
-					},
-					"svh": {
-						ClassGroupID: "h",
-					},
-					"lvh": {
-						ClassGroupID: "h",
-					},
-					"dvh": {
-						ClassGroupID: "h",
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "h",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "h",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "h",
-					},
-				},
-				ClassGroupID: "h",
-			},
-			"size": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "size",
-					},
-					"min": {
-						ClassGroupID: "size",
-					},
-					"max": {
-						ClassGroupID: "size",
-					},
-					"fit": {
-						ClassGroupID: "size",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "size",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "size",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "size",
-					},
-				},
-				ClassGroupID: "size",
-			},
-			"text": {
-				NextPart: map[string]classPart{
-					"base": {
-						ClassGroupID: "font-size",
-					},
-					"left": {
-						ClassGroupID: "text-alignment",
-					},
-					"center": {
-						ClassGroupID: "text-alignment",
-					},
-					"right": {
-						ClassGroupID: "text-alignment",
-					},
-					"justify": {
-						ClassGroupID: "text-alignment",
-					},
-					"start": {
-						ClassGroupID: "text-alignment",
-					},
-					"end": {
-						ClassGroupID: "text-alignment",
-					},
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "text-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "text-opacity",
-							},
+				"h": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "h",
 						},
-						ClassGroupID: "text-opacity",
-					},
-					"ellipsis": {
-						ClassGroupID: "text-overflow",
-					},
-					"clip": {
-						ClassGroupID: "text-overflow",
-					},
-					"wrap": {
-						ClassGroupID: "text-wrap",
-					},
-					"nowrap": {
-						ClassGroupID: "text-wrap",
-					},
-					"balance": {
-						ClassGroupID: "text-wrap",
-					},
-					"pretty": {
-						ClassGroupID: "text-wrap",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isTshirtSize,
-						ClassGroupID: "font-size",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "font-size",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "text-color",
-					},
-				},
-			},
-			"antialiased": {
-				ClassGroupID: "font-smoothing",
-			},
-			"subpixel": {
-				NextPart: map[string]classPart{
-					"antialiased": {
-						ClassGroupID: "font-smoothing",
-					},
-				},
-			},
-			"italic": {
-				ClassGroupID: "font-style",
-			},
-			"not": {
-				NextPart: map[string]classPart{
-					"italic": {
-						ClassGroupID: "font-style",
-					},
-					"sr": {
-						NextPart: map[string]classPart{
-							"only": {
-								ClassGroupID: "sr",
-							},
+						"min": {
+							ClassGroupID: "h",
+						},
+						"max": {
+							ClassGroupID: "h",
+						},
+						"fit": {
+							ClassGroupID: "h",
+						},
+						"svh": {
+							ClassGroupID: "h",
+						},
+						"lvh": {
+							ClassGroupID: "h",
+						},
+						"dvh": {
+							ClassGroupID: "h",
 						},
 					},
-				},
-			},
-			"font": {
-				NextPart: map[string]classPart{
-					"thin": {
-						ClassGroupID: "font-weight",
-					},
-					"extralight": {
-						ClassGroupID: "font-weight",
-					},
-					"light": {
-						ClassGroupID: "font-weight",
-					},
-					"normal": {
-						ClassGroupID: "font-weight",
-					},
-					"medium": {
-						ClassGroupID: "font-weight",
-					},
-					"semibold": {
-						ClassGroupID: "font-weight",
-					},
-					"bold": {
-						ClassGroupID: "font-weight",
-					},
-					"extrabold": {
-						ClassGroupID: "font-weight",
-					},
-					"black": {
-						ClassGroupID: "font-weight",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "font-weight",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "font-family",
-					},
-				},
-			},
-			"normal": {
-				NextPart: map[string]classPart{
-					"nums": {
-						ClassGroupID: "fvn-normal",
-					},
-					"case": {
-						ClassGroupID: "text-transform",
-					},
-				},
-			},
-			"ordinal": {
-				ClassGroupID: "fvn-ordinal",
-			},
-			"slashed": {
-				NextPart: map[string]classPart{
-					"zero": {
-						ClassGroupID: "fvn-slashed-zero",
-					},
-				},
-			},
-			"lining": {
-				NextPart: map[string]classPart{
-					"nums": {
-						ClassGroupID: "fvn-figure",
-					},
-				},
-			},
-			"oldstyle": {
-				NextPart: map[string]classPart{
-					"nums": {
-						ClassGroupID: "fvn-figure",
-					},
-				},
-			},
-			"proportional": {
-				NextPart: map[string]classPart{
-					"nums": {
-						ClassGroupID: "fvn-spacing",
-					},
-				},
-			},
-			"tabular": {
-				NextPart: map[string]classPart{
-					"nums": {
-						ClassGroupID: "fvn-spacing",
-					},
-				},
-			},
-			"diagonal": {
-				NextPart: map[string]classPart{
-					"fractions": {
-						ClassGroupID: "fvn-fraction",
-					},
-				},
-			},
-			"stacked": {
-				NextPart: map[string]classPart{
-					"fractons": {
-						ClassGroupID: "fvn-fraction",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "h",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "h",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "h",
+						},
 					},
+					ClassGroupID: "h",
 				},
-			},
-			"tracking": {
-				NextPart: map[string]classPart{
-					"tighter": {
-						ClassGroupID: "tracking",
-					},
-					"tight": {
-						ClassGroupID: "tracking",
-					},
-					"normal": {
-						ClassGroupID: "tracking",
-					},
-					"wide": {
-						ClassGroupID: "tracking",
-					},
-					"wider": {
-						ClassGroupID: "tracking",
-					},
-					"widest": {
-						ClassGroupID: "tracking",
+				"size": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "size",
+						},
+						"min": {
+							ClassGroupID: "size",
+						},
+						"max": {
+							ClassGroupID: "size",
+						},
+						"fit": {
+							ClassGroupID: "size",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "tracking",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "size",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "size",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "size",
+						},
 					},
+					ClassGroupID: "size",
 				},
-			},
-			"line": {
-				NextPart: map[string]classPart{
-					"clamp": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "line-clamp",
+				"text": {
+					NextPart: map[string]*ClassPart{
+						"base": {
+							ClassGroupID: "font-size",
+						},
+						"left": {
+							ClassGroupID: "text-alignment",
+						},
+						"center": {
+							ClassGroupID: "text-alignment",
+						},
+						"right": {
+							ClassGroupID: "text-alignment",
+						},
+						"justify": {
+							ClassGroupID: "text-alignment",
+						},
+						"start": {
+							ClassGroupID: "text-alignment",
+						},
+						"end": {
+							ClassGroupID: "text-alignment",
+						},
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "text-opacity",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "text-opacity",
+								},
 							},
+							ClassGroupID: "text-opacity",
+						},
+						"ellipsis": {
+							ClassGroupID: "text-overflow",
+						},
+						"clip": {
+							ClassGroupID: "text-overflow",
+						},
+						"wrap": {
+							ClassGroupID: "text-wrap",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "line-clamp",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "line-clamp",
-							},
+						"nowrap": {
+							ClassGroupID: "text-wrap",
+						},
+						"balance": {
+							ClassGroupID: "text-wrap",
+						},
+						"pretty": {
+							ClassGroupID: "text-wrap",
 						},
 					},
-					"through": {
-						ClassGroupID: "text-decoration",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isTshirtSize,
+							ClassGroupID: "font-size",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "font-size",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "text-color",
+						},
 					},
 				},
-			},
-			"leading": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "leading",
-					},
-					"tight": {
-						ClassGroupID: "leading",
-					},
-					"snug": {
-						ClassGroupID: "leading",
-					},
-					"normal": {
-						ClassGroupID: "leading",
-					},
-					"relaxed": {
-						ClassGroupID: "leading",
-					},
-					"loose": {
-						ClassGroupID: "leading",
-					},
+				"antialiased": {
+					ClassGroupID: "font-smoothing",
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isLength,
-						ClassGroupID: "leading",
-					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "leading",
+				"subpixel": {
+					NextPart: map[string]*ClassPart{
+						"antialiased": {
+							ClassGroupID: "font-smoothing",
+						},
 					},
 				},
-			},
-			"placeholder": {
-				NextPart: map[string]classPart{
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "placeholder-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "placeholder-opacity",
+				"italic": {
+					ClassGroupID: "font-style",
+				},
+				"not": {
+					NextPart: map[string]*ClassPart{
+						"italic": {
+							ClassGroupID: "font-style",
+						},
+						"sr": {
+							NextPart: map[string]*ClassPart{
+								"only": {
+									ClassGroupID: "sr",
+								},
 							},
 						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isAny,
-						ClassGroupID: "placeholder-color",
+				"font": {
+					NextPart: map[string]*ClassPart{
+						"thin": {
+							ClassGroupID: "font-weight",
+						},
+						"extralight": {
+							ClassGroupID: "font-weight",
+						},
+						"light": {
+							ClassGroupID: "font-weight",
+						},
+						"normal": {
+							ClassGroupID: "font-weight",
+						},
+						"medium": {
+							ClassGroupID: "font-weight",
+						},
+						"semibold": {
+							ClassGroupID: "font-weight",
+						},
+						"bold": {
+							ClassGroupID: "font-weight",
+						},
+						"extrabold": {
+							ClassGroupID: "font-weight",
+						},
+						"black": {
+							ClassGroupID: "font-weight",
+						},
 					},
-				},
-			},
-			"underline": {
-				NextPart: map[string]classPart{
-					"offset": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "underline-offset",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "font-weight",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "underline-offset",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "underline-offset",
-							},
+						{
+							Fn:           isAny,
+							ClassGroupID: "font-family",
 						},
 					},
 				},
-				ClassGroupID: "text-decoration",
-			},
-			"overline": {
-				ClassGroupID: "text-decoration",
-			},
-			"no": {
-				NextPart: map[string]classPart{
-					"underline": {
-						ClassGroupID: "text-decoration",
+				"normal": {
+					NextPart: map[string]*ClassPart{
+						"nums": {
+							ClassGroupID: "fvn-normal",
+						},
+						"case": {
+							ClassGroupID: "text-transform",
+						},
 					},
 				},
-			},
-			"decoration": {
-				NextPart: map[string]classPart{
-					"solid": {
-						ClassGroupID: "text-decoration-style",
-					},
-					"dashed": {
-						ClassGroupID: "text-decoration-style",
-					},
-					"dotted": {
-						ClassGroupID: "text-decoration-style",
-					},
-					"double": {
-						ClassGroupID: "text-decoration-style",
-					},
-					"none": {
-						ClassGroupID: "text-decoration-style",
-					},
-					"wavy": {
-						ClassGroupID: "text-decoration-style",
-					},
-					"auto": {
-						ClassGroupID: "text-decoration-thickness",
-					},
-					"from": {
-						NextPart: map[string]classPart{
-							"font": {
-								ClassGroupID: "text-decoration-thickness",
-							},
+				"ordinal": {
+					ClassGroupID: "fvn-ordinal",
+				},
+				"slashed": {
+					NextPart: map[string]*ClassPart{
+						"zero": {
+							ClassGroupID: "fvn-slashed-zero",
 						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isLength,
-						ClassGroupID: "text-decoration-thickness",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "text-decoration-thickness",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "text-decoration-color",
+				"lining": {
+					NextPart: map[string]*ClassPart{
+						"nums": {
+							ClassGroupID: "fvn-figure",
+						},
 					},
 				},
-				ClassGroupID: "",
-			},
-			"uppercase": {
-				ClassGroupID: "text-transform",
-			},
-			"lowercase": {
-				ClassGroupID: "text-transform",
-			},
-			"capitalize": {
-				ClassGroupID: "text-transform",
-			},
-			"truncate": {
-				ClassGroupID: "text-overflow",
-			},
-			"indent": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "indent",
-					},
-					{
-						Fn:           isLength,
-						ClassGroupID: "indent",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "indent",
+				"oldstyle": {
+					NextPart: map[string]*ClassPart{
+						"nums": {
+							ClassGroupID: "fvn-figure",
+						},
 					},
 				},
-			},
-			"align": {
-				NextPart: map[string]classPart{
-					"baseline": {
-						ClassGroupID: "vertical-align",
-					},
-					"top": {
-						ClassGroupID: "vertical-align",
-					},
-					"middle": {
-						ClassGroupID: "vertical-align",
-					},
-					"bottom": {
-						ClassGroupID: "vertical-align",
-					},
-					"text": {
-						NextPart: map[string]classPart{
-							"top": {
-								ClassGroupID: "vertical-align",
-							},
-							"bottom": {
-								ClassGroupID: "vertical-align",
-							},
+				"proportional": {
+					NextPart: map[string]*ClassPart{
+						"nums": {
+							ClassGroupID: "fvn-spacing",
 						},
 					},
-					"sub": {
-						ClassGroupID: "vertical-align",
+				},
+				"tabular": {
+					NextPart: map[string]*ClassPart{
+						"nums": {
+							ClassGroupID: "fvn-spacing",
+						},
 					},
-					"super": {
-						ClassGroupID: "vertical-align",
+				},
+				"diagonal": {
+					NextPart: map[string]*ClassPart{
+						"fractions": {
+							ClassGroupID: "fvn-fraction",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "vertical-align",
+				"stacked": {
+					NextPart: map[string]*ClassPart{
+						"fractons": {
+							ClassGroupID: "fvn-fraction",
+						},
 					},
 				},
-			},
-			"whitespace": {
-				NextPart: map[string]classPart{
-					"normal": {
-						ClassGroupID: "whitespace",
+				"tracking": {
+					NextPart: map[string]*ClassPart{
+						"tighter": {
+							ClassGroupID: "tracking",
+						},
+						"tight": {
+							ClassGroupID: "tracking",
+						},
+						"normal": {
+							ClassGroupID: "tracking",
+						},
+						"wide": {
+							ClassGroupID: "tracking",
+						},
+						"wider": {
+							ClassGroupID: "tracking",
+						},
+						"widest": {
+							ClassGroupID: "tracking",
+						},
 					},
-					"nowrap": {
-						ClassGroupID: "whitespace",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "tracking",
+						},
 					},
-					"pre": {
-						NextPart: map[string]classPart{
-							"line": {
-								ClassGroupID: "whitespace",
+				},
+				"line": {
+					NextPart: map[string]*ClassPart{
+						"clamp": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "line-clamp",
+								},
 							},
-							"wrap": {
-								ClassGroupID: "whitespace",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "line-clamp",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "line-clamp",
+								},
 							},
 						},
-						ClassGroupID: "whitespace",
-					},
-					"break": {
-						NextPart: map[string]classPart{
-							"spaces": {
-								ClassGroupID: "whitespace",
-							},
+						"through": {
+							ClassGroupID: "text-decoration",
 						},
-						ClassGroupID: "",
 					},
 				},
-			},
-			"hyphens": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "hyphens",
-					},
-					"manual": {
-						ClassGroupID: "hyphens",
+				"leading": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "leading",
+						},
+						"tight": {
+							ClassGroupID: "leading",
+						},
+						"snug": {
+							ClassGroupID: "leading",
+						},
+						"normal": {
+							ClassGroupID: "leading",
+						},
+						"relaxed": {
+							ClassGroupID: "leading",
+						},
+						"loose": {
+							ClassGroupID: "leading",
+						},
 					},
-					"auto": {
-						ClassGroupID: "hyphens",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isLength,
+							ClassGroupID: "leading",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "leading",
+						},
 					},
 				},
-			},
-			"bg": {
-				NextPart: map[string]classPart{
-					"fixed": {
-						ClassGroupID: "bg-attachment",
-					},
-					"local": {
-						ClassGroupID: "bg-attachment",
-					},
-					"scroll": {
-						ClassGroupID: "bg-attachment",
-					},
-					"clip": {
-						NextPart: map[string]classPart{
-							"border": {
-								ClassGroupID: "bg-clip",
-							},
-							"padding": {
-								ClassGroupID: "bg-clip",
-							},
-							"content": {
-								ClassGroupID: "bg-clip",
-							},
-							"text": {
-								ClassGroupID: "bg-clip",
+				"placeholder": {
+					NextPart: map[string]*ClassPart{
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "placeholder-opacity",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "placeholder-opacity",
+								},
 							},
 						},
 					},
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "bg-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "bg-opacity",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isAny,
+							ClassGroupID: "placeholder-color",
 						},
 					},
-					"origin": {
-						NextPart: map[string]classPart{
-							"border": {
-								ClassGroupID: "bg-origin",
-							},
-							"padding": {
-								ClassGroupID: "bg-origin",
+				},
+				"underline": {
+					NextPart: map[string]*ClassPart{
+						"offset": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "underline-offset",
+								},
 							},
-							"content": {
-								ClassGroupID: "bg-origin",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "underline-offset",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "underline-offset",
+								},
 							},
 						},
 					},
-					"bottom": {
-						ClassGroupID: "bg-position",
-					},
-					"center": {
-						ClassGroupID: "bg-position",
+					ClassGroupID: "text-decoration",
+				},
+				"overline": {
+					ClassGroupID: "text-decoration",
+				},
+				"no": {
+					NextPart: map[string]*ClassPart{
+						"underline": {
+							ClassGroupID: "text-decoration",
+						},
 					},
-					"left": {
-						NextPart: map[string]classPart{
-							"bottom": {
-								ClassGroupID: "bg-position",
-							},
-							"top": {
-								ClassGroupID: "bg-position",
+				},
+				"decoration": {
+					NextPart: map[string]*ClassPart{
+						"solid": {
+							ClassGroupID: "text-decoration-style",
+						},
+						"dashed": {
+							ClassGroupID: "text-decoration-style",
+						},
+						"dotted": {
+							ClassGroupID: "text-decoration-style",
+						},
+						"double": {
+							ClassGroupID: "text-decoration-style",
+						},
+						"none": {
+							ClassGroupID: "text-decoration-style",
+						},
+						"wavy": {
+							ClassGroupID: "text-decoration-style",
+						},
+						"auto": {
+							ClassGroupID: "text-decoration-thickness",
+						},
+						"from": {
+							NextPart: map[string]*ClassPart{
+								"font": {
+									ClassGroupID: "text-decoration-thickness",
+								},
 							},
 						},
-						ClassGroupID: "bg-position",
 					},
-					"right": {
-						NextPart: map[string]classPart{
-							"bottom": {
-								ClassGroupID: "bg-position",
-							},
-							"top": {
-								ClassGroupID: "bg-position",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isLength,
+							ClassGroupID: "text-decoration-thickness",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "text-decoration-thickness",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "text-decoration-color",
 						},
-						ClassGroupID: "bg-position",
 					},
-					"top": {
-						ClassGroupID: "bg-position",
+					ClassGroupID: "",
+				},
+				"uppercase": {
+					ClassGroupID: "text-transform",
+				},
+				"lowercase": {
+					ClassGroupID: "text-transform",
+				},
+				"capitalize": {
+					ClassGroupID: "text-transform",
+				},
+				"truncate": {
+					ClassGroupID: "text-overflow",
+				},
+				"indent": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "indent",
+						},
+						{
+							Fn:           isLength,
+							ClassGroupID: "indent",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "indent",
+						},
 					},
-					"no": {
-						NextPart: map[string]classPart{
-							"repeat": {
-								ClassGroupID: "bg-repeat",
+				},
+				"align": {
+					NextPart: map[string]*ClassPart{
+						"baseline": {
+							ClassGroupID: "vertical-align",
+						},
+						"top": {
+							ClassGroupID: "vertical-align",
+						},
+						"middle": {
+							ClassGroupID: "vertical-align",
+						},
+						"bottom": {
+							ClassGroupID: "vertical-align",
+						},
+						"text": {
+							NextPart: map[string]*ClassPart{
+								"top": {
+									ClassGroupID: "vertical-align",
+								},
+								"bottom": {
+									ClassGroupID: "vertical-align",
+								},
 							},
 						},
+						"sub": {
+							ClassGroupID: "vertical-align",
+						},
+						"super": {
+							ClassGroupID: "vertical-align",
+						},
 					},
-					"repeat": {
-						NextPart: map[string]classPart{
-							"x": {
-								ClassGroupID: "bg-repeat",
-							},
-							"y": {
-								ClassGroupID: "bg-repeat",
-							},
-							"round": {
-								ClassGroupID: "bg-repeat",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "vertical-align",
+						},
+					},
+				},
+				"whitespace": {
+					NextPart: map[string]*ClassPart{
+						"normal": {
+							ClassGroupID: "whitespace",
+						},
+						"nowrap": {
+							ClassGroupID: "whitespace",
+						},
+						"pre": {
+							NextPart: map[string]*ClassPart{
+								"line": {
+									ClassGroupID: "whitespace",
+								},
+								"wrap": {
+									ClassGroupID: "whitespace",
+								},
 							},
-							"space": {
-								ClassGroupID: "bg-repeat",
+							ClassGroupID: "whitespace",
+						},
+						"break": {
+							NextPart: map[string]*ClassPart{
+								"spaces": {
+									ClassGroupID: "whitespace",
+								},
 							},
+							ClassGroupID: "",
 						},
-						ClassGroupID: "bg-repeat",
 					},
-					"auto": {
-						ClassGroupID: "bg-size",
-					},
-					"cover": {
-						ClassGroupID: "bg-size",
-					},
-					"contain": {
-						ClassGroupID: "bg-size",
-					},
-					"none": {
-						ClassGroupID: "bg-image",
+				},
+				"hyphens": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "hyphens",
+						},
+						"manual": {
+							ClassGroupID: "hyphens",
+						},
+						"auto": {
+							ClassGroupID: "hyphens",
+						},
 					},
-					"gradient": {
-						NextPart: map[string]classPart{
-							"to": {
-								NextPart: map[string]classPart{
-									"t": {
-										ClassGroupID: "bg-image",
-									},
-									"tr": {
-										ClassGroupID: "bg-image",
-									},
-									"r": {
-										ClassGroupID: "bg-image",
-									},
-									"br": {
-										ClassGroupID: "bg-image",
-									},
-									"b": {
-										ClassGroupID: "bg-image",
-									},
-									"bl": {
-										ClassGroupID: "bg-image",
-									},
-									"l": {
-										ClassGroupID: "bg-image",
-									},
-									"tl": {
-										ClassGroupID: "bg-image",
-									},
+				},
+				"bg": {
+					NextPart: map[string]*ClassPart{
+						"fixed": {
+							ClassGroupID: "bg-attachment",
+						},
+						"local": {
+							ClassGroupID: "bg-attachment",
+						},
+						"scroll": {
+							ClassGroupID: "bg-attachment",
+						},
+						"clip": {
+							NextPart: map[string]*ClassPart{
+								"border": {
+									ClassGroupID: "bg-clip",
+								},
+								"padding": {
+									ClassGroupID: "bg-clip",
+								},
+								"content": {
+									ClassGroupID: "bg-clip",
+								},
+								"text": {
+									ClassGroupID: "bg-clip",
 								},
 							},
 						},
-					},
-					"blend": {
-						NextPart: map[string]classPart{
-							"normal": {
-								ClassGroupID: "bg-blend",
-							},
-							"multiply": {
-								ClassGroupID: "bg-blend",
-							},
-							"screen": {
-								ClassGroupID: "bg-blend",
-							},
-							"overlay": {
-								ClassGroupID: "bg-blend",
-							},
-							"darken": {
-								ClassGroupID: "bg-blend",
-							},
-							"lighten": {
-								ClassGroupID: "bg-blend",
-							},
-							"color": {
-								NextPart: map[string]classPart{
-									"dodge": {
-										ClassGroupID: "bg-blend",
-									},
-									"burn": {
-										ClassGroupID: "bg-blend",
-									},
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "bg-opacity",
 								},
-							},
-							"hard": {
-								NextPart: map[string]classPart{
-									"light": {
-										ClassGroupID: "bg-blend",
-									},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "bg-opacity",
 								},
 							},
-							"soft": {
-								NextPart: map[string]classPart{
-									"light": {
-										ClassGroupID: "bg-blend",
-									},
+						},
+						"origin": {
+							NextPart: map[string]*ClassPart{
+								"border": {
+									ClassGroupID: "bg-origin",
+								},
+								"padding": {
+									ClassGroupID: "bg-origin",
+								},
+								"content": {
+									ClassGroupID: "bg-origin",
 								},
 							},
-							"difference": {
-								ClassGroupID: "bg-blend",
-							},
-							"exclusion": {
-								ClassGroupID: "bg-blend",
-							},
-							"hue": {
-								ClassGroupID: "bg-blend",
-							},
-							"saturation": {
-								ClassGroupID: "bg-blend",
-							},
-							"luminosity": {
-								ClassGroupID: "bg-blend",
+						},
+						"bottom": {
+							ClassGroupID: "bg-position",
+						},
+						"center": {
+							ClassGroupID: "bg-position",
+						},
+						"left": {
+							NextPart: map[string]*ClassPart{
+								"bottom": {
+									ClassGroupID: "bg-position",
+								},
+								"top": {
+									ClassGroupID: "bg-position",
+								},
 							},
-							"plus": {
-								NextPart: map[string]classPart{
-									"lighter": {
-										ClassGroupID: "bg-blend",
-									},
+							ClassGroupID: "bg-position",
+						},
+						"right": {
+							NextPart: map[string]*ClassPart{
+								"bottom": {
+									ClassGroupID: "bg-position",
+								},
+								"top": {
+									ClassGroupID: "bg-position",
 								},
 							},
+							ClassGroupID: "bg-position",
 						},
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryPosition,
-						ClassGroupID: "bg-position",
-					},
-					{
-						Fn:           isArbitrarySize,
-						ClassGroupID: "bg-size",
-					},
-					{
-						Fn:           isArbitraryImage,
-						ClassGroupID: "bg-image",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "bg-color",
-					},
-				},
-			},
-			"from": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isPercent,
-						ClassGroupID: "gradient-from-pos",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "gradient-from-pos",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "gradient-from",
-					},
-				},
-			},
-			"via": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isPercent,
-						ClassGroupID: "gradient-via-pos",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "gradient-via-pos",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "gradient-via",
-					},
-				},
-			},
-			"to": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isPercent,
-						ClassGroupID: "gradient-to-pos",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "gradient-to-pos",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "gradient-to",
-					},
-				},
-			},
-			"rounded": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "rounded",
-					},
-					"full": {
-						ClassGroupID: "rounded",
-					},
-					"s": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-s",
+						"top": {
+							ClassGroupID: "bg-position",
+						},
+						"no": {
+							NextPart: map[string]*ClassPart{
+								"repeat": {
+									ClassGroupID: "bg-repeat",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-s",
+						},
+						"repeat": {
+							NextPart: map[string]*ClassPart{
+								"x": {
+									ClassGroupID: "bg-repeat",
+								},
+								"y": {
+									ClassGroupID: "bg-repeat",
+								},
+								"round": {
+									ClassGroupID: "bg-repeat",
+								},
+								"space": {
+									ClassGroupID: "bg-repeat",
+								},
 							},
+							ClassGroupID: "bg-repeat",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-s",
+						"auto": {
+							ClassGroupID: "bg-size",
+						},
+						"cover": {
+							ClassGroupID: "bg-size",
+						},
+						"contain": {
+							ClassGroupID: "bg-size",
+						},
+						"none": {
+							ClassGroupID: "bg-image",
+						},
+						"gradient": {
+							NextPart: map[string]*ClassPart{
+								"to": {
+									NextPart: map[string]*ClassPart{
+										"t": {
+											ClassGroupID: "bg-image",
+										},
+										"tr": {
+											ClassGroupID: "bg-image",
+										},
+										"r": {
+											ClassGroupID: "bg-image",
+										},
+										"br": {
+											ClassGroupID: "bg-image",
+										},
+										"b": {
+											ClassGroupID: "bg-image",
+										},
+										"bl": {
+											ClassGroupID: "bg-image",
+										},
+										"l": {
+											ClassGroupID: "bg-image",
+										},
+										"tl": {
+											ClassGroupID: "bg-image",
+										},
+									},
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-s",
+						},
+						"blend": {
+							NextPart: map[string]*ClassPart{
+								"normal": {
+									ClassGroupID: "bg-blend",
+								},
+								"multiply": {
+									ClassGroupID: "bg-blend",
+								},
+								"screen": {
+									ClassGroupID: "bg-blend",
+								},
+								"overlay": {
+									ClassGroupID: "bg-blend",
+								},
+								"darken": {
+									ClassGroupID: "bg-blend",
+								},
+								"lighten": {
+									ClassGroupID: "bg-blend",
+								},
+								"color": {
+									NextPart: map[string]*ClassPart{
+										"dodge": {
+											ClassGroupID: "bg-blend",
+										},
+										"burn": {
+											ClassGroupID: "bg-blend",
+										},
+									},
+								},
+								"hard": {
+									NextPart: map[string]*ClassPart{
+										"light": {
+											ClassGroupID: "bg-blend",
+										},
+									},
+								},
+								"soft": {
+									NextPart: map[string]*ClassPart{
+										"light": {
+											ClassGroupID: "bg-blend",
+										},
+									},
+								},
+								"difference": {
+									ClassGroupID: "bg-blend",
+								},
+								"exclusion": {
+									ClassGroupID: "bg-blend",
+								},
+								"hue": {
+									ClassGroupID: "bg-blend",
+								},
+								"saturation": {
+									ClassGroupID: "bg-blend",
+								},
+								"luminosity": {
+									ClassGroupID: "bg-blend",
+								},
+								"plus": {
+									NextPart: map[string]*ClassPart{
+										"lighter": {
+											ClassGroupID: "bg-blend",
+										},
+									},
+								},
 							},
 						},
-						ClassGroupID: "rounded-s",
 					},
-					"e": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-e",
-							},
-							"full": {
-								ClassGroupID: "rounded-e",
-							},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryPosition,
+							ClassGroupID: "bg-position",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-e",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-e",
-							},
+						{
+							Fn:           isArbitrarySize,
+							ClassGroupID: "bg-size",
 						},
-						ClassGroupID: "rounded-e",
-					},
-					"t": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-t",
-							},
-							"full": {
-								ClassGroupID: "rounded-t",
-							},
+						{
+							Fn:           isArbitraryImage,
+							ClassGroupID: "bg-image",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-t",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-t",
-							},
+						{
+							Fn:           isAny,
+							ClassGroupID: "bg-color",
 						},
-						ClassGroupID: "rounded-t",
 					},
-					"r": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-r",
-							},
-							"full": {
-								ClassGroupID: "rounded-r",
-							},
+				},
+				"from": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isPercent,
+							ClassGroupID: "gradient-from-pos",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-r",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-r",
-							},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "gradient-from-pos",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "gradient-from",
 						},
-						ClassGroupID: "rounded-r",
 					},
-					"b": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-b",
-							},
-							"full": {
-								ClassGroupID: "rounded-b",
-							},
+				},
+				"via": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isPercent,
+							ClassGroupID: "gradient-via-pos",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-b",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-b",
-							},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "gradient-via-pos",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "gradient-via",
 						},
-						ClassGroupID: "rounded-b",
 					},
-					"l": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-l",
-							},
-							"full": {
-								ClassGroupID: "rounded-l",
-							},
+				},
+				"to": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isPercent,
+							ClassGroupID: "gradient-to-pos",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-l",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-l",
-							},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "gradient-to-pos",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "gradient-to",
 						},
-						ClassGroupID: "rounded-l",
 					},
-					"ss": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-ss",
-							},
-							"full": {
-								ClassGroupID: "rounded-ss",
-							},
+				},
+				"rounded": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "rounded",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-ss",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-ss",
-							},
+						"full": {
+							ClassGroupID: "rounded",
 						},
-						ClassGroupID: "rounded-ss",
-					},
-					"se": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-se",
+						"s": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-s",
+								},
+								"full": {
+									ClassGroupID: "rounded-s",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-se",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-s",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-s",
+								},
 							},
+							ClassGroupID: "rounded-s",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-se",
+						"e": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-e",
+								},
+								"full": {
+									ClassGroupID: "rounded-e",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-se",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-e",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-e",
+								},
 							},
+							ClassGroupID: "rounded-e",
 						},
-						ClassGroupID: "rounded-se",
-					},
-					"ee": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-ee",
+						"t": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-t",
+								},
+								"full": {
+									ClassGroupID: "rounded-t",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-ee",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-t",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-t",
+								},
 							},
+							ClassGroupID: "rounded-t",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-ee",
+						"r": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-r",
+								},
+								"full": {
+									ClassGroupID: "rounded-r",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-ee",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-r",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-r",
+								},
 							},
+							ClassGroupID: "rounded-r",
 						},
-						ClassGroupID: "rounded-ee",
-					},
-					"es": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-es",
+						"b": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-b",
+								},
+								"full": {
+									ClassGroupID: "rounded-b",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-es",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-b",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-b",
+								},
 							},
+							ClassGroupID: "rounded-b",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-es",
+						"l": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-l",
+								},
+								"full": {
+									ClassGroupID: "rounded-l",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-es",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-l",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-l",
+								},
 							},
+							ClassGroupID: "rounded-l",
 						},
-						ClassGroupID: "rounded-es",
-					},
-					"tl": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-tl",
+						"ss": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-ss",
+								},
+								"full": {
+									ClassGroupID: "rounded-ss",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-tl",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-ss",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-ss",
+								},
 							},
+							ClassGroupID: "rounded-ss",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-tl",
+						"se": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-se",
+								},
+								"full": {
+									ClassGroupID: "rounded-se",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-tl",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-se",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-se",
+								},
 							},
+							ClassGroupID: "rounded-se",
 						},
-						ClassGroupID: "rounded-tl",
-					},
-					"tr": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-tr",
+						"ee": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-ee",
+								},
+								"full": {
+									ClassGroupID: "rounded-ee",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-tr",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-ee",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-ee",
+								},
 							},
+							ClassGroupID: "rounded-ee",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-tr",
+						"es": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-es",
+								},
+								"full": {
+									ClassGroupID: "rounded-es",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-tr",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-es",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-es",
+								},
 							},
+							ClassGroupID: "rounded-es",
 						},
-						ClassGroupID: "rounded-tr",
-					},
-					"br": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-br",
+						"tl": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-tl",
+								},
+								"full": {
+									ClassGroupID: "rounded-tl",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-br",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-tl",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-tl",
+								},
 							},
+							ClassGroupID: "rounded-tl",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-br",
+						"tr": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-tr",
+								},
+								"full": {
+									ClassGroupID: "rounded-tr",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-br",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-tr",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-tr",
+								},
 							},
+							ClassGroupID: "rounded-tr",
 						},
-						ClassGroupID: "rounded-br",
-					},
-					"bl": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "rounded-bl",
+						"br": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-br",
+								},
+								"full": {
+									ClassGroupID: "rounded-br",
+								},
 							},
-							"full": {
-								ClassGroupID: "rounded-bl",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-br",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-br",
+								},
 							},
+							ClassGroupID: "rounded-br",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "rounded-bl",
+						"bl": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "rounded-bl",
+								},
+								"full": {
+									ClassGroupID: "rounded-bl",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "rounded-bl",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "rounded-bl",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "rounded-bl",
+								},
 							},
+							ClassGroupID: "rounded-bl",
 						},
-						ClassGroupID: "rounded-bl",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isTshirtSize,
-						ClassGroupID: "rounded",
 					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "rounded",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isTshirtSize,
+							ClassGroupID: "rounded",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "rounded",
+						},
 					},
+					ClassGroupID: "rounded",
 				},
-				ClassGroupID: "rounded",
-			},
-			"border": {
-				NextPart: map[string]classPart{
-					"x": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-x",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-x",
-							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "border-color-x",
+				"border": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-x",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-x",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "border-color-x",
+								},
 							},
+							ClassGroupID: "border-w-x",
 						},
-						ClassGroupID: "border-w-x",
-					},
-					"y": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-y",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-y",
-							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "border-color-y",
+						"y": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-y",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-y",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "border-color-y",
+								},
 							},
+							ClassGroupID: "border-w-y",
 						},
-						ClassGroupID: "border-w-y",
-					},
-					"s": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-s",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-s",
+						"s": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-s",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-s",
+								},
 							},
+							ClassGroupID: "border-w-s",
 						},
-						ClassGroupID: "border-w-s",
-					},
-					"e": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-e",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-e",
+						"e": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-e",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-e",
+								},
 							},
+							ClassGroupID: "border-w-e",
 						},
-						ClassGroupID: "border-w-e",
-					},
-					"t": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-t",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-t",
-							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "border-color-t",
+						"t": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-t",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-t",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "border-color-t",
+								},
 							},
+							ClassGroupID: "border-w-t",
 						},
-						ClassGroupID: "border-w-t",
-					},
-					"r": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-r",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-r",
-							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "border-color-r",
+						"r": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-r",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-r",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "border-color-r",
+								},
 							},
+							ClassGroupID: "border-w-r",
 						},
-						ClassGroupID: "border-w-r",
-					},
-					"b": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-b",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-b",
-							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "border-color-b",
+						"b": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-b",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-b",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "border-color-b",
+								},
 							},
+							ClassGroupID: "border-w-b",
 						},
-						ClassGroupID: "border-w-b",
-					},
-					"l": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-w-l",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-w-l",
-							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "border-color-l",
+						"l": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-w-l",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-w-l",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "border-color-l",
+								},
 							},
+							ClassGroupID: "border-w-l",
 						},
-						ClassGroupID: "border-w-l",
-					},
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "border-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "border-opacity",
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "border-opacity",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "border-opacity",
+								},
 							},
+							ClassGroupID: "border-opacity",
 						},
-						ClassGroupID: "border-opacity",
-					},
-					"solid": {
-						ClassGroupID: "border-style",
-					},
-					"dashed": {
-						ClassGroupID: "border-style",
-					},
-					"dotted": {
-						ClassGroupID: "border-style",
-					},
-					"double": {
-						ClassGroupID: "border-style",
-					},
-					"none": {
-						ClassGroupID: "border-style",
-					},
-					"hidden": {
-						ClassGroupID: "border-style",
-					},
-					"collapse": {
-						ClassGroupID: "border-collapse",
-					},
-					"separate": {
-						ClassGroupID: "border-collapse",
-					},
-					"spacing": {
-						NextPart: map[string]classPart{
-							"x": {
-								Validators: []classGroupValidator{
-									{
-										Fn:           isArbitraryValue,
-										ClassGroupID: "border-spacing-x",
-									},
-									{
-										Fn:           isLength,
-										ClassGroupID: "border-spacing-x",
-									},
-									{
-										Fn:           isArbitraryLength,
-										ClassGroupID: "border-spacing-x",
+						"solid": {
+							ClassGroupID: "border-style",
+						},
+						"dashed": {
+							ClassGroupID: "border-style",
+						},
+						"dotted": {
+							ClassGroupID: "border-style",
+						},
+						"double": {
+							ClassGroupID: "border-style",
+						},
+						"none": {
+							ClassGroupID: "border-style",
+						},
+						"hidden": {
+							ClassGroupID: "border-style",
+						},
+						"collapse": {
+							ClassGroupID: "border-collapse",
+						},
+						"separate": {
+							ClassGroupID: "border-collapse",
+						},
+						"spacing": {
+							NextPart: map[string]*ClassPart{
+								"x": {
+									Validators: []ClassGroupValidator{
+										{
+											Fn:           isArbitraryValue,
+											ClassGroupID: "border-spacing-x",
+										},
+										{
+											Fn:           isLength,
+											ClassGroupID: "border-spacing-x",
+										},
+										{
+											Fn:           isArbitraryLength,
+											ClassGroupID: "border-spacing-x",
+										},
 									},
 								},
-							},
-							"y": {
-								Validators: []classGroupValidator{
-									{
-										Fn:           isArbitraryValue,
-										ClassGroupID: "border-spacing-y",
-									},
-									{
-										Fn:           isLength,
-										ClassGroupID: "border-spacing-y",
-									},
-									{
-										Fn:           isArbitraryLength,
-										ClassGroupID: "border-spacing-y",
+								"y": {
+									Validators: []ClassGroupValidator{
+										{
+											Fn:           isArbitraryValue,
+											ClassGroupID: "border-spacing-y",
+										},
+										{
+											Fn:           isLength,
+											ClassGroupID: "border-spacing-y",
+										},
+										{
+											Fn:           isArbitraryLength,
+											ClassGroupID: "border-spacing-y",
+										},
 									},
 								},
 							},
-						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "border-spacing",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "border-spacing",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "border-spacing",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "border-spacing",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "border-spacing",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "border-spacing",
+								},
 							},
 						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isLength,
-						ClassGroupID: "border-w",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "border-w",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "border-color",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isLength,
+							ClassGroupID: "border-w",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "border-w",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "border-color",
+						},
 					},
+					ClassGroupID: "border-w",
 				},
-				ClassGroupID: "border-w",
-			},
-			"divide": {
-				NextPart: map[string]classPart{
-					"x": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								ClassGroupID: "divide-x-reverse",
+				"divide": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									ClassGroupID: "divide-x-reverse",
+								},
+							},
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "divide-x",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "divide-x",
+								},
 							},
+							ClassGroupID: "divide-x",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "divide-x",
+						"y": {
+							NextPart: map[string]*ClassPart{
+								"reverse": {
+									ClassGroupID: "divide-y-reverse",
+								},
 							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "divide-x",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "divide-y",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "divide-y",
+								},
 							},
+							ClassGroupID: "divide-y",
 						},
-						ClassGroupID: "divide-x",
-					},
-					"y": {
-						NextPart: map[string]classPart{
-							"reverse": {
-								ClassGroupID: "divide-y-reverse",
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "divide-opacity",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "divide-opacity",
+								},
 							},
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "divide-y",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "divide-y",
-							},
+						"solid": {
+							ClassGroupID: "divide-style",
 						},
-						ClassGroupID: "divide-y",
-					},
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "divide-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "divide-opacity",
-							},
+						"dashed": {
+							ClassGroupID: "divide-style",
+						},
+						"dotted": {
+							ClassGroupID: "divide-style",
+						},
+						"double": {
+							ClassGroupID: "divide-style",
+						},
+						"none": {
+							ClassGroupID: "divide-style",
 						},
 					},
-					"solid": {
-						ClassGroupID: "divide-style",
-					},
-					"dashed": {
-						ClassGroupID: "divide-style",
-					},
-					"dotted": {
-						ClassGroupID: "divide-style",
-					},
-					"double": {
-						ClassGroupID: "divide-style",
-					},
-					"none": {
-						ClassGroupID: "divide-style",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isAny,
-						ClassGroupID: "divide-color",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isAny,
+							ClassGroupID: "divide-color",
+						},
 					},
 				},
-			},
-			"outline": {
-				NextPart: map[string]classPart{
-					"solid": {
-						ClassGroupID: "outline-style",
-					},
-					"dashed": {
-						ClassGroupID: "outline-style",
-					},
-					"dotted": {
-						ClassGroupID: "outline-style",
-					},
-					"double": {
-						ClassGroupID: "outline-style",
-					},
-					"none": {
-						ClassGroupID: "outline-style",
-					},
-					"offset": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "outline-offset",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "outline-offset",
+				"outline": {
+					NextPart: map[string]*ClassPart{
+						"solid": {
+							ClassGroupID: "outline-style",
+						},
+						"dashed": {
+							ClassGroupID: "outline-style",
+						},
+						"dotted": {
+							ClassGroupID: "outline-style",
+						},
+						"double": {
+							ClassGroupID: "outline-style",
+						},
+						"none": {
+							ClassGroupID: "outline-style",
+						},
+						"offset": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "outline-offset",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "outline-offset",
+								},
 							},
 						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isLength,
-						ClassGroupID: "outline-w",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "outline-w",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "outline-color",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isLength,
+							ClassGroupID: "outline-w",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "outline-w",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "outline-color",
+						},
 					},
+					ClassGroupID: "outline-style",
 				},
-				ClassGroupID: "outline-style",
-			},
-			"ring": {
-				NextPart: map[string]classPart{
-					"inset": {
-						ClassGroupID: "ring-w-inset",
-					},
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "ring-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "ring-opacity",
-							},
+				"ring": {
+					NextPart: map[string]*ClassPart{
+						"inset": {
+							ClassGroupID: "ring-w-inset",
 						},
-					},
-					"offset": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isLength,
-								ClassGroupID: "ring-offset-w",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "ring-offset-w",
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "ring-opacity",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "ring-opacity",
+								},
 							},
-							{
-								Fn:           isAny,
-								ClassGroupID: "ring-offset-color",
+						},
+						"offset": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isLength,
+									ClassGroupID: "ring-offset-w",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "ring-offset-w",
+								},
+								{
+									Fn:           isAny,
+									ClassGroupID: "ring-offset-color",
+								},
 							},
 						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isLength,
-						ClassGroupID: "ring-w",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "ring-w",
-					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "ring-color",
-					},
-				},
-				ClassGroupID: "ring-w",
-			},
-			"shadow": {
-				NextPart: map[string]classPart{
-					"inner": {
-						ClassGroupID: "shadow",
-					},
-					"none": {
-						ClassGroupID: "shadow",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isLength,
+							ClassGroupID: "ring-w",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "ring-w",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "ring-color",
+						},
 					},
+					ClassGroupID: "ring-w",
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isTshirtSize,
-						ClassGroupID: "shadow",
-					},
-					{
-						Fn:           isArbitraryShadow,
-						ClassGroupID: "shadow",
+				"shadow": {
+					NextPart: map[string]*ClassPart{
+						"inner": {
+							ClassGroupID: "shadow",
+						},
+						"none": {
+							ClassGroupID: "shadow",
+						},
 					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "shadow-color",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isTshirtSize,
+							ClassGroupID: "shadow",
+						},
+						{
+							Fn:           isArbitraryShadow,
+							ClassGroupID: "shadow",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "shadow-color",
+						},
 					},
+					ClassGroupID: "shadow",
 				},
-				ClassGroupID: "shadow",
-			},
-			"opacity": {
-				NextPart: map[string]classPart{},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "opacity",
-					},
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "opacity",
+				"opacity": {
+					NextPart: map[string]*ClassPart{},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "opacity",
+						},
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "opacity",
+						},
 					},
 				},
-			},
-			"mix": {
-				NextPart: map[string]classPart{
-					"blend": {
-						NextPart: map[string]classPart{
-							"normal": {
-								ClassGroupID: "mix-blend",
-							},
-							"multiply": {
-								ClassGroupID: "mix-blend",
-							},
-							"screen": {
-								ClassGroupID: "mix-blend",
-							},
-							"overlay": {
-								ClassGroupID: "mix-blend",
-							},
-							"darken": {
-								ClassGroupID: "mix-blend",
-							},
-							"lighten": {
-								ClassGroupID: "mix-blend",
-							},
-							"color": {
-								NextPart: map[string]classPart{
-									"dodge": {
-										ClassGroupID: "mix-blend",
-									},
-									"burn": {
-										ClassGroupID: "mix-blend",
+				"mix": {
+					NextPart: map[string]*ClassPart{
+						"blend": {
+							NextPart: map[string]*ClassPart{
+								"normal": {
+									ClassGroupID: "mix-blend",
+								},
+								"multiply": {
+									ClassGroupID: "mix-blend",
+								},
+								"screen": {
+									ClassGroupID: "mix-blend",
+								},
+								"overlay": {
+									ClassGroupID: "mix-blend",
+								},
+								"darken": {
+									ClassGroupID: "mix-blend",
+								},
+								"lighten": {
+									ClassGroupID: "mix-blend",
+								},
+								"color": {
+									NextPart: map[string]*ClassPart{
+										"dodge": {
+											ClassGroupID: "mix-blend",
+										},
+										"burn": {
+											ClassGroupID: "mix-blend",
+										},
 									},
+									ClassGroupID: "mix-blend",
 								},
-								ClassGroupID: "mix-blend",
-							},
-							"hard": {
-								NextPart: map[string]classPart{
-									"light": {
-										ClassGroupID: "mix-blend",
+								"hard": {
+									NextPart: map[string]*ClassPart{
+										"light": {
+											ClassGroupID: "mix-blend",
+										},
 									},
 								},
-							},
-							"soft": {
-								NextPart: map[string]classPart{
-									"light": {
-										ClassGroupID: "mix-blend",
+								"soft": {
+									NextPart: map[string]*ClassPart{
+										"light": {
+											ClassGroupID: "mix-blend",
+										},
 									},
 								},
-							},
-							"difference": {
-								ClassGroupID: "mix-blend",
-							},
-							"exclusion": {
-								ClassGroupID: "mix-blend",
-							},
-							"hue": {
-								ClassGroupID: "mix-blend",
-							},
-							"saturation": {
-								ClassGroupID: "mix-blend",
-							},
-							"luminosity": {
-								ClassGroupID: "mix-blend",
-							},
-							"plus": {
-								NextPart: map[string]classPart{
-									"lighter": {
-										ClassGroupID: "mix-blend",
+								"difference": {
+									ClassGroupID: "mix-blend",
+								},
+								"exclusion": {
+									ClassGroupID: "mix-blend",
+								},
+								"hue": {
+									ClassGroupID: "mix-blend",
+								},
+								"saturation": {
+									ClassGroupID: "mix-blend",
+								},
+								"luminosity": {
+									ClassGroupID: "mix-blend",
+								},
+								"plus": {
+									NextPart: map[string]*ClassPart{
+										"lighter": {
+											ClassGroupID: "mix-blend",
+										},
 									},
 								},
 							},
 						},
 					},
 				},
-			},
-			"filter": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "filter",
-					},
-				},
-				ClassGroupID: "filter",
-			},
-			"blur": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "blur",
+				"filter": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "filter",
+						},
 					},
+					ClassGroupID: "filter",
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isTshirtSize,
-						ClassGroupID: "blur",
+				"blur": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "blur",
+						},
 					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "blur",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isTshirtSize,
+							ClassGroupID: "blur",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "blur",
+						},
 					},
+					ClassGroupID: "blur",
 				},
-				ClassGroupID: "blur",
-			},
-			"brightness": {
-				NextPart: map[string]classPart{},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "brightness",
-					},
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "brightness",
+				"brightness": {
+					NextPart: map[string]*ClassPart{},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "brightness",
+						},
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "brightness",
+						},
 					},
+					ClassGroupID: "brightness",
 				},
-				ClassGroupID: "brightness",
-			},
-			"contrast": {
-				NextPart: map[string]classPart{},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "contrast",
-					},
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "contrast",
+				"contrast": {
+					NextPart: map[string]*ClassPart{},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "contrast",
+						},
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "contrast",
+						},
 					},
+					ClassGroupID: "contrast",
 				},
-				ClassGroupID: "contrast",
-			},
-			"drop": {
-				NextPart: map[string]classPart{
-					"shadow": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "drop-shadow",
-							},
-						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "drop-shadow",
+				"drop": {
+					NextPart: map[string]*ClassPart{
+						"shadow": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "drop-shadow",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "drop-shadow",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "drop-shadow",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "drop-shadow",
+								},
 							},
+							ClassGroupID: "drop-shadow",
 						},
-						ClassGroupID: "drop-shadow",
 					},
 				},
-			},
-			"grayscale": {
-				NextPart: map[string]classPart{
-					"0": {
-						ClassGroupID: "grayscale",
+				"grayscale": {
+					NextPart: map[string]*ClassPart{
+						"0": {
+							ClassGroupID: "grayscale",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "grayscale",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "grayscale",
+						},
 					},
+					ClassGroupID: "grayscale",
 				},
-				ClassGroupID: "grayscale",
-			},
-			"hue": {
-				NextPart: map[string]classPart{
-					"rotate": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "hue-rotate",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "hue-rotate",
+				"hue": {
+					NextPart: map[string]*ClassPart{
+						"rotate": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "hue-rotate",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "hue-rotate",
+								},
 							},
 						},
 					},
 				},
-			},
-			"invert": {
-				NextPart: map[string]classPart{
-					"0": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "invert",
+				"invert": {
+					NextPart: map[string]*ClassPart{
+						"0": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "invert",
+								},
 							},
+							ClassGroupID: "invert",
 						},
-						ClassGroupID: "invert",
 					},
+					ClassGroupID: "invert",
 				},
-				ClassGroupID: "invert",
-			},
-			"saturate": {
-				NextPart: map[string]classPart{},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "saturate",
-					},
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "saturate",
-					},
-				},
-				ClassGroupID: "saturate",
-			},
-			"sepia": {
-				NextPart: map[string]classPart{
-					"0": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "sepia",
-							},
+				"saturate": {
+					NextPart: map[string]*ClassPart{},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "saturate",
+						},
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "saturate",
 						},
-						ClassGroupID: "sepia",
 					},
+					ClassGroupID: "saturate",
 				},
-				ClassGroupID: "sepia",
-			},
-			"backdrop": {
-				NextPart: map[string]classPart{
-					"filter": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "backdrop-filter",
+				"sepia": {
+					NextPart: map[string]*ClassPart{
+						"0": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "sepia",
+								},
 							},
+							ClassGroupID: "sepia",
 						},
-						ClassGroupID: "backdrop-filter",
 					},
-					"blur": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "backdrop-blur",
+					ClassGroupID: "sepia",
+				},
+				"backdrop": {
+					NextPart: map[string]*ClassPart{
+						"filter": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "backdrop-filter",
+								},
 							},
+							ClassGroupID: "backdrop-filter",
 						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isTshirtSize,
-								ClassGroupID: "backdrop-blur",
+						"blur": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "backdrop-blur",
+								},
 							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "backdrop-blur",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isTshirtSize,
+									ClassGroupID: "backdrop-blur",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "backdrop-blur",
+								},
 							},
+							ClassGroupID: "backdrop-blur",
 						},
-						ClassGroupID: "backdrop-blur",
-					},
-					"brightness": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "backdrop-brightness",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "backdrop-brightness",
+						"brightness": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "backdrop-brightness",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "backdrop-brightness",
+								},
 							},
+							ClassGroupID: "backdrop-brightness",
 						},
-						ClassGroupID: "backdrop-brightness",
-					},
-					"contrast": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "backdrop-contrast",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "backdrop-contrast",
+						"contrast": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "backdrop-contrast",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "backdrop-contrast",
+								},
 							},
+							ClassGroupID: "backdrop-contrast",
 						},
-						ClassGroupID: "backdrop-contrast",
-					},
-					"grayscale": {
-						NextPart: map[string]classPart{
-							"0": {
-								ClassGroupID: "backdrop-grayscale",
+						"grayscale": {
+							NextPart: map[string]*ClassPart{
+								"0": {
+									ClassGroupID: "backdrop-grayscale",
+								},
 							},
-						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "backdrop-grayscale",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "backdrop-grayscale",
+								},
 							},
-						},
-						ClassGroupID: "backdrop-grayscale",
-					},
-					"hue": {
-						NextPart: map[string]classPart{
-							"rotate": {
-								Validators: []classGroupValidator{
-									{
-										Fn:           isNumber,
-										ClassGroupID: "backdrop-hue-rotate",
-									},
-									{
-										Fn:           isArbitraryValue,
-										ClassGroupID: "backdrop-hue-rotate",
+							ClassGroupID: "backdrop-grayscale",
+						},
+						"hue": {
+							NextPart: map[string]*ClassPart{
+								"rotate": {
+									Validators: []ClassGroupValidator{
+										{
+											Fn:           isNumber,
+											ClassGroupID: "backdrop-hue-rotate",
+										},
+										{
+											Fn:           isArbitraryValue,
+											ClassGroupID: "backdrop-hue-rotate",
+										},
 									},
 								},
 							},
+							Validators: []ClassGroupValidator{},
 						},
-						Validators: []classGroupValidator{},
-					},
-					"invert": {
-						NextPart: map[string]classPart{
-							"0": {
-								Validators: []classGroupValidator{
-									{
-										Fn:           isArbitraryValue,
-										ClassGroupID: "backdrop-invert",
+						"invert": {
+							NextPart: map[string]*ClassPart{
+								"0": {
+									Validators: []ClassGroupValidator{
+										{
+											Fn:           isArbitraryValue,
+											ClassGroupID: "backdrop-invert",
+										},
 									},
+									ClassGroupID: "backdrop-invert",
 								},
-								ClassGroupID: "backdrop-invert",
 							},
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "backdrop-invert",
 						},
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "backdrop-invert",
-					},
-					"opacity": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "backdrop-opacity",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "backdrop-opacity",
+						"opacity": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "backdrop-opacity",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "backdrop-opacity",
+								},
 							},
+							ClassGroupID: "backdrop-opacity",
 						},
-						ClassGroupID: "backdrop-opacity",
-					},
-					"saturate": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "backdrop-saturate",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "backdrop-saturate",
+						"saturate": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "backdrop-saturate",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "backdrop-saturate",
+								},
 							},
+							ClassGroupID: "backdrop-saturate",
 						},
-						ClassGroupID: "backdrop-saturate",
-					},
-					"sepia": {
-						NextPart: map[string]classPart{
-							"0": {
-								Validators: []classGroupValidator{
-									{
-										Fn:           isArbitraryValue,
-										ClassGroupID: "backdrop-sepia",
+						"sepia": {
+							NextPart: map[string]*ClassPart{
+								"0": {
+									Validators: []ClassGroupValidator{
+										{
+											Fn:           isArbitraryValue,
+											ClassGroupID: "backdrop-sepia",
+										},
 									},
+									ClassGroupID: "backdrop-sepia",
 								},
-								ClassGroupID: "backdrop-sepia",
 							},
+							ClassGroupID: "backdrop-sepia",
 						},
-						ClassGroupID: "backdrop-sepia",
 					},
 				},
-			},
-			"caption": {
-				NextPart: map[string]classPart{
-					"top": {
-						ClassGroupID: "caption",
-					},
-					"bottom": {
-						ClassGroupID: "caption",
+				"caption": {
+					NextPart: map[string]*ClassPart{
+						"top": {
+							ClassGroupID: "caption",
+						},
+						"bottom": {
+							ClassGroupID: "caption",
+						},
 					},
+					Validators: []ClassGroupValidator{},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"transition": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "transition",
-					},
-					"all": {
-						ClassGroupID: "transition",
-					},
-					"colors": {
-						ClassGroupID: "transition",
-					},
-					"opacity": {
-						ClassGroupID: "transition",
-					},
-					"shadow": {
-						ClassGroupID: "transition",
-					},
-					"transform": {
-						ClassGroupID: "transition",
+				"transition": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "transition",
+						},
+						"all": {
+							ClassGroupID: "transition",
+						},
+						"colors": {
+							ClassGroupID: "transition",
+						},
+						"opacity": {
+							ClassGroupID: "transition",
+						},
+						"shadow": {
+							ClassGroupID: "transition",
+						},
+						"transform": {
+							ClassGroupID: "transition",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "transition",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "transition",
+						},
 					},
+					ClassGroupID: "transition",
 				},
-				ClassGroupID: "transition",
-			},
-			"duration": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "duration",
-					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "duration",
+				"duration": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "duration",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "duration",
+						},
 					},
 				},
-			},
-			"ease": {
-				NextPart: map[string]classPart{
-					"linear": {
-						ClassGroupID: "ease",
-					},
-					"in": {
-						NextPart: map[string]classPart{
-							"out": {
-								ClassGroupID: "ease",
+				"ease": {
+					NextPart: map[string]*ClassPart{
+						"linear": {
+							ClassGroupID: "ease",
+						},
+						"in": {
+							NextPart: map[string]*ClassPart{
+								"out": {
+									ClassGroupID: "ease",
+								},
 							},
+							ClassGroupID: "ease",
+						},
+						"out": {
+							ClassGroupID: "ease",
 						},
-						ClassGroupID: "ease",
-					},
-					"out": {
-						ClassGroupID: "ease",
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "ease",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "ease",
+						},
 					},
 				},
-			},
-			"delay": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "delay",
-					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "delay",
+				"delay": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "delay",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "delay",
+						},
 					},
 				},
-			},
-			"animate": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "animate",
-					},
-					"spin": {
-						ClassGroupID: "animate",
-					},
-					"ping": {
-						ClassGroupID: "animate",
-					},
-					"pulse": {
-						ClassGroupID: "animate",
-					},
-					"bounce": {
-						ClassGroupID: "animate",
+				"animate": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "animate",
+						},
+						"spin": {
+							ClassGroupID: "animate",
+						},
+						"ping": {
+							ClassGroupID: "animate",
+						},
+						"pulse": {
+							ClassGroupID: "animate",
+						},
+						"bounce": {
+							ClassGroupID: "animate",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "animate",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "animate",
+						},
 					},
 				},
-			},
-			"transform": {
-				NextPart: map[string]classPart{
-					"gpu": {
-						ClassGroupID: "transform",
-					},
-					"none": {
-						ClassGroupID: "transform",
+				"transform": {
+					NextPart: map[string]*ClassPart{
+						"gpu": {
+							ClassGroupID: "transform",
+						},
+						"none": {
+							ClassGroupID: "transform",
+						},
 					},
+					ClassGroupID: "transform",
 				},
-				ClassGroupID: "transform",
-			},
-			"scale": {
-				NextPart: map[string]classPart{
-					"x": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "scale-x",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "scale-x",
+				"scale": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "scale-x",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "scale-x",
+								},
 							},
 						},
-					},
-					"y": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "scale-y",
-							},
-							{
-								Fn:           isArbitraryNumber,
-								ClassGroupID: "scale-y",
+						"y": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "scale-y",
+								},
+								{
+									Fn:           isArbitraryNumber,
+									ClassGroupID: "scale-y",
+								},
 							},
 						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isNumber,
-						ClassGroupID: "scale",
-					},
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "scale",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isNumber,
+							ClassGroupID: "scale",
+						},
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "scale",
+						},
 					},
 				},
-			},
-			"rotate": {
-				Validators: []classGroupValidator{
-					{
-						Fn:           isInteger,
-						ClassGroupID: "rotate",
-					},
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "rotate",
+				"rotate": {
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isInteger,
+							ClassGroupID: "rotate",
+						},
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "rotate",
+						},
 					},
 				},
-			},
-			"translate": {
-				NextPart: map[string]classPart{
-					"x": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "translate-x",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "translate-x",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "translate-x",
+				"translate": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "translate-x",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "translate-x",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "translate-x",
+								},
 							},
 						},
-					},
-					"y": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "translate-y",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "translate-y",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "translate-y",
+						"y": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "translate-y",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "translate-y",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "translate-y",
+								},
 							},
 						},
 					},
 				},
-			},
-			"skew": {
-				NextPart: map[string]classPart{
-					"x": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "skew-x",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "skew-x",
+				"skew": {
+					NextPart: map[string]*ClassPart{
+						"x": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "skew-x",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "skew-x",
+								},
 							},
 						},
-					},
-					"y": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isNumber,
-								ClassGroupID: "skew-y",
-							},
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "skew-y",
+						"y": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isNumber,
+									ClassGroupID: "skew-y",
+								},
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "skew-y",
+								},
 							},
 						},
 					},
 				},
-			},
-			"origin": {
-				NextPart: map[string]classPart{
-					"center": {
-						ClassGroupID: "transform-origin",
-					},
-					"top": {
-						NextPart: map[string]classPart{
-							"right": {
-								ClassGroupID: "transform-origin",
-							},
-							"left": {
-								ClassGroupID: "transform-origin",
-							},
+				"origin": {
+					NextPart: map[string]*ClassPart{
+						"center": {
+							ClassGroupID: "transform-origin",
 						},
-						ClassGroupID: "transform-origin",
-					},
-					"right": {
-						ClassGroupID: "transform-origin",
-					},
-					"bottom": {
-						NextPart: map[string]classPart{
-							"right": {
-								ClassGroupID: "transform-origin",
+						"top": {
+							NextPart: map[string]*ClassPart{
+								"right": {
+									ClassGroupID: "transform-origin",
+								},
+								"left": {
+									ClassGroupID: "transform-origin",
+								},
 							},
-							"left": {
-								ClassGroupID: "transform-origin",
+							ClassGroupID: "transform-origin",
+						},
+						"right": {
+							ClassGroupID: "transform-origin",
+						},
+						"bottom": {
+							NextPart: map[string]*ClassPart{
+								"right": {
+									ClassGroupID: "transform-origin",
+								},
+								"left": {
+									ClassGroupID: "transform-origin",
+								},
 							},
+							ClassGroupID: "transform-origin",
+						},
+						"left": {
+							ClassGroupID: "transform-origin",
 						},
-						ClassGroupID: "transform-origin",
-					},
-					"left": {
-						ClassGroupID: "transform-origin",
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "transform-origin",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "transform-origin",
+						},
 					},
 				},
-			},
-			"accent": {
-				NextPart: map[string]classPart{
-					"auto": {
-						NextPart:     map[string]classPart{},
-						Validators:   []classGroupValidator{},
-						ClassGroupID: "accent",
+				"accent": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							NextPart:     map[string]*ClassPart{},
+							Validators:   []ClassGroupValidator{},
+							ClassGroupID: "accent",
+						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isAny,
-						ClassGroupID: "accent",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isAny,
+							ClassGroupID: "accent",
+						},
 					},
 				},
-			},
-			"appearance": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "appearance",
-					},
-					"auto": {
-						ClassGroupID: "appearance",
+				"appearance": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "appearance",
+						},
+						"auto": {
+							ClassGroupID: "appearance",
+						},
 					},
 				},
-			},
-			"cursor": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "cursor",
-					},
-					"default": {
-						ClassGroupID: "cursor",
-					},
-					"pointer": {
-						ClassGroupID: "cursor",
-					},
-					"wait": {
-						ClassGroupID: "cursor",
-					},
-					"text": {
-						ClassGroupID: "cursor",
-					},
-					"move": {
-						ClassGroupID: "cursor",
-					},
-					"help": {
-						ClassGroupID: "cursor",
-					},
-					"not": {
-						NextPart: map[string]classPart{
-							"allowed": {
-								ClassGroupID: "cursor",
-							},
+				"cursor": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "cursor",
 						},
-					},
-					"none": {
-						ClassGroupID: "cursor",
-					},
-					"context": {
-						NextPart: map[string]classPart{
-							"menu": {
-								ClassGroupID: "cursor",
+						"default": {
+							ClassGroupID: "cursor",
+						},
+						"pointer": {
+							ClassGroupID: "cursor",
+						},
+						"wait": {
+							ClassGroupID: "cursor",
+						},
+						"text": {
+							ClassGroupID: "cursor",
+						},
+						"move": {
+							ClassGroupID: "cursor",
+						},
+						"help": {
+							ClassGroupID: "cursor",
+						},
+						"not": {
+							NextPart: map[string]*ClassPart{
+								"allowed": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"progress": {
-						ClassGroupID: "cursor",
-					},
-					"cell": {
-						ClassGroupID: "cursor",
-					},
-					"crosshair": {
-						ClassGroupID: "cursor",
-					},
-					"vertical": {
-						NextPart: map[string]classPart{
-							"text": {
-								ClassGroupID: "cursor",
+						"none": {
+							ClassGroupID: "cursor",
+						},
+						"context": {
+							NextPart: map[string]*ClassPart{
+								"menu": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"alias": {
-						ClassGroupID: "cursor",
-					},
-					"copy": {
-						ClassGroupID: "cursor",
-					},
-					"no": {
-						NextPart: map[string]classPart{
-							"drop": {
-								ClassGroupID: "cursor",
+						"progress": {
+							ClassGroupID: "cursor",
+						},
+						"cell": {
+							ClassGroupID: "cursor",
+						},
+						"crosshair": {
+							ClassGroupID: "cursor",
+						},
+						"vertical": {
+							NextPart: map[string]*ClassPart{
+								"text": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"grab": {
-						ClassGroupID: "cursor",
-					},
-					"grabbing": {
-						ClassGroupID: "cursor",
-					},
-					"all": {
-						NextPart: map[string]classPart{
-							"scroll": {
-								ClassGroupID: "cursor",
+						"alias": {
+							ClassGroupID: "cursor",
+						},
+						"copy": {
+							ClassGroupID: "cursor",
+						},
+						"no": {
+							NextPart: map[string]*ClassPart{
+								"drop": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"col": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"grab": {
+							ClassGroupID: "cursor",
+						},
+						"grabbing": {
+							ClassGroupID: "cursor",
+						},
+						"all": {
+							NextPart: map[string]*ClassPart{
+								"scroll": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"row": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"col": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"n": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"row": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"e": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"n": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"s": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"e": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"w": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"s": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"ne": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"w": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"nw": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"ne": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"se": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"nw": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"sw": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"se": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"ew": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"sw": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"ns": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"ew": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"nesw": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"ns": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"nwse": {
-						NextPart: map[string]classPart{
-							"resize": {
-								ClassGroupID: "cursor",
+						"nesw": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
-					},
-					"zoom": {
-						NextPart: map[string]classPart{
-							"in": {
-								ClassGroupID: "cursor",
+						"nwse": {
+							NextPart: map[string]*ClassPart{
+								"resize": {
+									ClassGroupID: "cursor",
+								},
 							},
-							"out": {
-								ClassGroupID: "cursor",
+						},
+						"zoom": {
+							NextPart: map[string]*ClassPart{
+								"in": {
+									ClassGroupID: "cursor",
+								},
+								"out": {
+									ClassGroupID: "cursor",
+								},
 							},
 						},
 					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isArbitraryValue,
-						ClassGroupID: "cursor",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isArbitraryValue,
+							ClassGroupID: "cursor",
+						},
 					},
 				},
-			},
-			"caret": {
-				NextPart: map[string]classPart{},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isAny,
-						ClassGroupID: "caret-color",
+				"caret": {
+					NextPart: map[string]*ClassPart{},
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isAny,
+							ClassGroupID: "caret-color",
+						},
 					},
 				},
-			},
-			"pointer": {
-				NextPart: map[string]classPart{
-					"events": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "pointer-events",
-							},
-							"auto": {
-								ClassGroupID: "pointer-events",
+				"pointer": {
+					NextPart: map[string]*ClassPart{
+						"events": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "pointer-events",
+								},
+								"auto": {
+									ClassGroupID: "pointer-events",
+								},
 							},
 						},
 					},
 				},
-			},
-			"resize": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "resize",
-					},
-					"y": {
-						ClassGroupID: "resize",
-					},
-					"x": {
-						ClassGroupID: "resize",
+				"resize": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "resize",
+						},
+						"y": {
+							ClassGroupID: "resize",
+						},
+						"x": {
+							ClassGroupID: "resize",
+						},
 					},
+					ClassGroupID: "resize",
 				},
-				ClassGroupID: "resize",
-			},
-			"scroll": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "scroll-behavior",
-					},
-					"smooth": {
-						ClassGroupID: "scroll-behavior",
-					},
-					"m": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-m",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-m",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-m",
-							},
+				"scroll": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "scroll-behavior",
 						},
-					},
-					"mx": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-mx",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-mx",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-mx",
-							},
+						"smooth": {
+							ClassGroupID: "scroll-behavior",
 						},
-					},
-					"my": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-my",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-my",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-my",
+						"m": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-m",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-m",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-m",
+								},
 							},
 						},
-					},
-					"ms": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-ms",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-ms",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-ms",
+						"mx": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-mx",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-mx",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-mx",
+								},
 							},
 						},
-					},
-					"me": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-me",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-me",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-me",
+						"my": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-my",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-my",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-my",
+								},
 							},
 						},
-					},
-					"mt": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-mt",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-mt",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-mt",
+						"ms": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-ms",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-ms",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-ms",
+								},
 							},
 						},
-					},
-					"mr": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-mr",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-mr",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-mr",
+						"me": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-me",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-me",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-me",
+								},
 							},
 						},
-					},
-					"mb": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-mb",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-mb",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-mb",
+						"mt": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-mt",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-mt",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-mt",
+								},
 							},
 						},
-					},
-					"ml": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-ml",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-ml",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-ml",
+						"mr": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-mr",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-mr",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-mr",
+								},
 							},
 						},
-					},
-					"p": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-p",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-p",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-p",
+						"mb": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-mb",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-mb",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-mb",
+								},
 							},
 						},
-					},
-					"px": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-px",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-px",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-px",
+						"ml": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-ml",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-ml",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-ml",
+								},
 							},
 						},
-					},
-					"py": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-py",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-py",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-py",
+						"p": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-p",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-p",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-p",
+								},
 							},
 						},
-					},
-					"ps": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-ps",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-ps",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-ps",
+						"px": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-px",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-px",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-px",
+								},
 							},
 						},
-					},
-					"pe": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-pe",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-pe",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-pe",
+						"py": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-py",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-py",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-py",
+								},
 							},
 						},
-					},
-					"pt": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-pt",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-pt",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-pt",
+						"ps": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-ps",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-ps",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-ps",
+								},
 							},
 						},
-					},
-					"pr": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-pr",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-pr",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-pr",
+						"pe": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-pe",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-pe",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-pe",
+								},
 							},
 						},
-					},
-					"pb": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-pb",
-							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-pb",
-							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-pb",
+						"pt": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-pt",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-pt",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-pt",
+								},
 							},
 						},
-					},
-					"pl": {
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "scroll-pl",
+						"pr": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-pr",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-pr",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-pr",
+								},
 							},
-							{
-								Fn:           isLength,
-								ClassGroupID: "scroll-pl",
+						},
+						"pb": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-pb",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-pb",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-pb",
+								},
 							},
-							{
-								Fn:           isArbitraryLength,
-								ClassGroupID: "scroll-pl",
+						},
+						"pl": {
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "scroll-pl",
+								},
+								{
+									Fn:           isLength,
+									ClassGroupID: "scroll-pl",
+								},
+								{
+									Fn:           isArbitraryLength,
+									ClassGroupID: "scroll-pl",
+								},
 							},
 						},
 					},
 				},
-			},
-			"snap": {
-				NextPart: map[string]classPart{
-					"start": {
-						ClassGroupID: "snap-align",
-					},
-					"end": {
-						ClassGroupID: "snap-align",
-					},
-					"center": {
-						ClassGroupID: "snap-align",
-					},
-					"align": {
-						NextPart: map[string]classPart{
-							"none": {
-								ClassGroupID: "snap-align",
+				"snap": {
+					NextPart: map[string]*ClassPart{
+						"start": {
+							ClassGroupID: "snap-align",
+						},
+						"end": {
+							ClassGroupID: "snap-align",
+						},
+						"center": {
+							ClassGroupID: "snap-align",
+						},
+						"align": {
+							NextPart: map[string]*ClassPart{
+								"none": {
+									ClassGroupID: "snap-align",
+								},
 							},
 						},
-					},
-					"normal": {
-						ClassGroupID: "snap-stop",
-					},
-					"always": {
-						ClassGroupID: "snap-stop",
-					},
-					"none": {
-						ClassGroupID: "snap-type",
-					},
-					"x": {
-						ClassGroupID: "snap-type",
-					},
-					"y": {
-						ClassGroupID: "snap-type",
-					},
-					"both": {
-						ClassGroupID: "snap-type",
-					},
-					"mandatory": {
-						ClassGroupID: "snap-strictness",
-					},
-					"proximity": {
-						ClassGroupID: "snap-strictness",
+						"normal": {
+							ClassGroupID: "snap-stop",
+						},
+						"always": {
+							ClassGroupID: "snap-stop",
+						},
+						"none": {
+							ClassGroupID: "snap-type",
+						},
+						"x": {
+							ClassGroupID: "snap-type",
+						},
+						"y": {
+							ClassGroupID: "snap-type",
+						},
+						"both": {
+							ClassGroupID: "snap-type",
+						},
+						"mandatory": {
+							ClassGroupID: "snap-strictness",
+						},
+						"proximity": {
+							ClassGroupID: "snap-strictness",
+						},
 					},
 				},
-			},
-			"touch": {
-				NextPart: map[string]classPart{
-					"auto": {
-						ClassGroupID: "touch",
-					},
-					"none": {
-						ClassGroupID: "touch",
-					},
-					"manipulation": {
-						ClassGroupID: "touch",
-					},
-					"pan": {
-						NextPart: map[string]classPart{
-							"x": {
-								ClassGroupID: "touch-x",
-							},
-							"left": {
-								ClassGroupID: "touch-x",
-							},
-							"right": {
-								ClassGroupID: "touch-x",
-							},
-							"y": {
-								ClassGroupID: "touch-y",
-							},
-							"up": {
-								ClassGroupID: "touch-y",
-							},
-							"down": {
-								ClassGroupID: "touch-y",
+				"touch": {
+					NextPart: map[string]*ClassPart{
+						"auto": {
+							ClassGroupID: "touch",
+						},
+						"none": {
+							ClassGroupID: "touch",
+						},
+						"manipulation": {
+							ClassGroupID: "touch",
+						},
+						"pan": {
+							NextPart: map[string]*ClassPart{
+								"x": {
+									ClassGroupID: "touch-x",
+								},
+								"left": {
+									ClassGroupID: "touch-x",
+								},
+								"right": {
+									ClassGroupID: "touch-x",
+								},
+								"y": {
+									ClassGroupID: "touch-y",
+								},
+								"up": {
+									ClassGroupID: "touch-y",
+								},
+								"down": {
+									ClassGroupID: "touch-y",
+								},
 							},
 						},
-					},
-					"pinch": {
-						NextPart: map[string]classPart{
-							"zoom": {
-								ClassGroupID: "touch-pz",
+						"pinch": {
+							NextPart: map[string]*ClassPart{
+								"zoom": {
+									ClassGroupID: "touch-pz",
+								},
 							},
 						},
 					},
 				},
-			},
-			"select": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "select",
-					},
-					"text": {
-						ClassGroupID: "select",
-					},
-					"all": {
-						ClassGroupID: "select",
-					},
-					"auto": {
-						ClassGroupID: "select",
+				"select": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "select",
+						},
+						"text": {
+							ClassGroupID: "select",
+						},
+						"all": {
+							ClassGroupID: "select",
+						},
+						"auto": {
+							ClassGroupID: "select",
+						},
 					},
+					Validators: []ClassGroupValidator{},
 				},
-				Validators: []classGroupValidator{},
-			},
-			"will": {
-				NextPart: map[string]classPart{
-					"change": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "will-change",
-							},
-							"scroll": {
-								ClassGroupID: "will-change",
-							},
-							"contents": {
-								ClassGroupID: "will-change",
-							},
-							"transform": {
-								ClassGroupID: "will-change",
+				"will": {
+					NextPart: map[string]*ClassPart{
+						"change": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "will-change",
+								},
+								"scroll": {
+									ClassGroupID: "will-change",
+								},
+								"contents": {
+									ClassGroupID: "will-change",
+								},
+								"transform": {
+									ClassGroupID: "will-change",
+								},
 							},
-						},
-						Validators: []classGroupValidator{
-							{
-								Fn:           isArbitraryValue,
-								ClassGroupID: "will-change",
+							Validators: []ClassGroupValidator{
+								{
+									Fn:           isArbitraryValue,
+									ClassGroupID: "will-change",
+								},
 							},
 						},
 					},
 				},
-			},
-			"fill": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "fill",
-					},
-				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isAny,
-						ClassGroupID: "fill",
+				"fill": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "fill",
+						},
 					},
-				},
-			},
-			"stroke": {
-				NextPart: map[string]classPart{
-					"none": {
-						ClassGroupID: "stroke",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isAny,
+							ClassGroupID: "fill",
+						},
 					},
 				},
-				Validators: []classGroupValidator{
-					{
-						Fn:           isLength,
-						ClassGroupID: "stroke-w",
-					},
-					{
-						Fn:           isArbitraryLength,
-						ClassGroupID: "stroke-w",
-					},
-					{
-						Fn:           isArbitraryNumber,
-						ClassGroupID: "stroke-w",
+				"stroke": {
+					NextPart: map[string]*ClassPart{
+						"none": {
+							ClassGroupID: "stroke",
+						},
 					},
-					{
-						Fn:           isAny,
-						ClassGroupID: "stroke",
+					Validators: []ClassGroupValidator{
+						{
+							Fn:           isLength,
+							ClassGroupID: "stroke-w",
+						},
+						{
+							Fn:           isArbitraryLength,
+							ClassGroupID: "stroke-w",
+						},
+						{
+							Fn:           isArbitraryNumber,
+							ClassGroupID: "stroke-w",
+						},
+						{
+							Fn:           isAny,
+							ClassGroupID: "stroke",
+						},
 					},
 				},
-			},
-			"sr": {
-				NextPart: map[string]classPart{
-					"only": {
-						ClassGroupID: "sr",
+				"sr": {
+					NextPart: map[string]*ClassPart{
+						"only": {
+							ClassGroupID: "sr",
+						},
 					},
 				},
-			},
-			"forced": {
-				NextPart: map[string]classPart{"color": {
-					NextPart: map[string]classPart{"adjust": {
-						NextPart: map[string]classPart{
-							"auto": {
-								ClassGroupID: "forced-color-adjust",
-							},
-							"none": {
-								ClassGroupID: "forced-color-adjust",
+				"forced": {
+					NextPart: map[string]*ClassPart{"color": {
+						NextPart: map[string]*ClassPart{"adjust": {
+							NextPart: map[string]*ClassPart{
+								"auto": {
+									ClassGroupID: "forced-color-adjust",
+								},
+								"none": {
+									ClassGroupID: "forced-color-adjust",
+								},
 							},
 						},
+						},
 					},
 					},
 				},
-				},
 			},
 		},
-	},
+	})
 }
@@ -43,3 +43,94 @@ func TestArbitraryShadow(t *testing.T) {
 		t.Error("isArbitraryShadow() should return false")
 	}
 }
+
+func TestArbitraryVariable(t *testing.T) {
+	if !isArbitraryValue("(--brand)") {
+		t.Error("isArbitraryValue() should return true")
+	}
+	if !isArbitraryLength("(length:--size)") {
+		t.Error("isArbitraryLength() should return true")
+	}
+	if isArbitraryLength("(--size)") {
+		t.Error("isArbitraryLength() should return false")
+	}
+	if isArbitraryValue("(brand)") {
+		t.Error("isArbitraryValue() should return false")
+	}
+}
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator func(string) bool
+		valid     []string
+		invalid   []string
+	}{
+		{
+			name:      "isInteger",
+			validator: isInteger,
+			valid:     []string{"0", "12", "-3", "+4"},
+			invalid:   []string{"", "-", "1.5", "1a", "px"},
+		},
+		{
+			name:      "isNumber",
+			validator: isNumber,
+			valid:     []string{"1", "1.5", ".5", "5.", "-2.5", "1e3", "1.5E-2"},
+			invalid:   []string{"", ".", "1.2.3", "1e", "e3", "inf", "1/2"},
+		},
+		{
+			name:      "isPercent",
+			validator: isPercent,
+			valid:     []string{"50%", "12.5%"},
+			invalid:   []string{"", "%", "50", "a%"},
+		},
+		{
+			name:      "isFraction",
+			validator: isFraction,
+			valid:     []string{"1/2", "11/12"},
+			invalid:   []string{"", "/", "1/", "/2", "1/2/3", "a/b"},
+		},
+		{
+			name:      "isTshirtSize",
+			validator: isTshirtSize,
+			valid:     []string{"xs", "sm", "2xl", "1.5xl"},
+			invalid:   []string{"", "x", "xxl", ".5xl", "1.xl", "2xs2"},
+		},
+		{
+			name:      "isImage",
+			validator: isImage,
+			valid: []string{
+				"url(a.png)",
+				"linear-gradient(red,blue)",
+				"repeating-conic-gradient(red,blue)",
+			},
+			invalid: []string{"", "url()", "url(a.png", "rgb(0,0,0)"},
+		},
+		{
+			name:      "isArbitraryValue",
+			validator: isArbitraryValue,
+			valid:     []string{"[10px]", "[length:10px]", "[a:]", "(--a)", "(--a:b)"},
+			invalid:   []string{"", "[]", "10px", "[10px", "(a)", "(--)"},
+		},
+		{
+			name:      "isArbitraryLength",
+			validator: isArbitraryLength,
+			valid:     []string{"[10px]", "[length:var(--x)]"},
+			invalid:   []string{"[size:10px]", "[a:]", "[red]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, val := range tt.valid {
+				if !tt.validator(val) {
+					t.Errorf("%s(%q) should return true", tt.name, val)
+				}
+			}
+			for _, val := range tt.invalid {
+				if tt.validator(val) {
+					t.Errorf("%s(%q) should return false", tt.name, val)
+				}
+			}
+		})
+	}
+}
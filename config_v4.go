@@ -0,0 +1,301 @@
+package twerge
+
+import "sync"
+
+// defaultConfigV4 is the default configuration for Tailwind CSS v4.
+//
+// It is the v3 configuration with the utilities added or renamed in v4,
+// built on first use so that importing twerge does not pay for it.
+var defaultConfigV4 = sync.OnceValue(newConfigV4)
+
+// DefaultConfigFor returns a copy of the default [Config] for the given
+// Tailwind CSS version.
+//
+// Unknown versions return the default v3 configuration.
+func DefaultConfigFor(v Version) *Config {
+	if v == V4 {
+		return defaultConfigV4().clone()
+	}
+	return defaultConfig().clone()
+}
+
+func newConfigV4() *Config {
+	return withV4Groups(defaultConfig())
+}
+
+// withV4Groups returns a copy of a config built on the v3 class groups with
+// the class groups added or changed in v4.
+func withV4Groups(base *Config) *Config {
+	cfg := MergeConfig(base, ConfigExtension{
+		Override: ConfigGroups{
+			ConflictingClassGroups: ConflictingClassGroups{
+				// text-lg no longer overrides leading-5 in v4, only
+				// text-lg/7 does (see ConflictingClassGroupModifiers)
+				"font-size": {},
+			},
+		},
+		Extend: ConfigGroups{
+			ClassGroups: map[string][]ClassDefinition{
+				"inset-shadow-color": {{Class: "inset-shadow", Validator: isAny}},
+				"inset-ring-color":   {{Class: "inset-ring", Validator: isAny}},
+				"text-shadow-color":  {{Class: "text-shadow", Validator: isAny}},
+			},
+		},
+	}, ConfigExtension{
+		// added after the colors, so that their validators are tried first
+		Extend: ConfigGroups{
+			ClassGroups: v4ClassGroups(),
+			ConflictingClassGroups: ConflictingClassGroups{
+				"mask-image-x-from": {"mask-image-r-from", "mask-image-l-from"},
+				"mask-image-x-to":   {"mask-image-r-to", "mask-image-l-to"},
+				"mask-image-y-from": {"mask-image-t-from", "mask-image-b-from"},
+				"mask-image-y-to":   {"mask-image-t-to", "mask-image-b-to"},
+				"mask-image":        {"mask-image-linear", "mask-image-radial", "mask-image-conic"},
+				"translate":         {"translate-x", "translate-y"},
+			},
+		},
+	})
+	cfg.Version = V4
+	cfg.v4Groups = true
+	return cfg
+}
+
+// v4ClassGroups returns the class groups added or changed in Tailwind CSS v4.
+func v4ClassGroups() map[string][]ClassDefinition {
+	return map[string][]ClassDefinition{
+		// Box Shadow
+		// @see https://tailwindcss.com/docs/box-shadow
+		"shadow": {
+			{Class: "shadow-2xs"},
+			{Class: "shadow-xs"},
+		},
+		"inset-shadow": {
+			{Class: "inset-shadow", Validator: isTshirtSize},
+			{Class: "inset-shadow", Validator: isArbitraryShadow},
+			{Class: "inset-shadow-none"},
+		},
+		"inset-ring-w": {
+			{Class: "inset-ring"},
+			{Class: "inset-ring", Validator: isLength},
+			{Class: "inset-ring", Validator: isArbitraryLength},
+		},
+		// Text Shadow
+		// @see https://tailwindcss.com/docs/text-shadow
+		"text-shadow": {
+			{Class: "text-shadow", Validator: isTshirtSize},
+			{Class: "text-shadow", Validator: isArbitraryShadow},
+			{Class: "text-shadow-none"},
+		},
+		// Border Radius
+		// @see https://tailwindcss.com/docs/border-radius
+		"rounded": {
+			{Class: "rounded-xs"},
+		},
+		// Outline Style
+		// @see https://tailwindcss.com/docs/outline-style
+		"outline-style": {
+			{Class: "outline-hidden"},
+		},
+		// Container Queries
+		// @see https://tailwindcss.com/docs/responsive-design#container-queries
+		"container-type": {
+			{Class: "@container"},
+			{Class: "@container-normal"},
+		},
+		// Field Sizing
+		// @see https://tailwindcss.com/docs/field-sizing
+		"field-sizing": {
+			{Class: "field-sizing-fixed"},
+			{Class: "field-sizing-content"},
+		},
+		// Color Scheme
+		// @see https://tailwindcss.com/docs/color-scheme
+		"color-scheme": literals(
+			"scheme",
+			"normal", "dark", "light", "light-dark", "only-dark", "only-light",
+		),
+		// Font Stretch
+		// @see https://tailwindcss.com/docs/font-stretch
+		"font-stretch": append(
+			literals(
+				"font-stretch",
+				"ultra-condensed", "extra-condensed", "condensed",
+				"semi-condensed", "normal", "semi-expanded", "expanded",
+				"extra-expanded", "ultra-expanded",
+			),
+			ClassDefinition{Class: "font-stretch", Validator: isPercent},
+			ClassDefinition{Class: "font-stretch", Validator: isArbitraryValue},
+		),
+		// Overflow Wrap
+		// @see https://tailwindcss.com/docs/overflow-wrap
+		"wrap": literals("wrap", "break-word", "anywhere", "normal"),
+		// Background Image
+		// @see https://tailwindcss.com/docs/background-image
+		"bg-image": {
+			{Class: "bg-linear", Validator: isAny},
+			{Class: "bg-radial"},
+			{Class: "bg-radial", Validator: isAny},
+			{Class: "bg-conic"},
+			{Class: "bg-conic", Validator: isAny},
+		},
+		// Perspective
+		// @see https://tailwindcss.com/docs/perspective
+		"perspective": append(
+			literals(
+				"perspective",
+				"dramatic", "near", "normal", "midrange", "distant", "none",
+			),
+			ClassDefinition{Class: "perspective", Validator: isArbitraryValue},
+		),
+		"perspective-origin": append(
+			literals("perspective-origin", positions...),
+			ClassDefinition{Class: "perspective-origin", Validator: isArbitraryValue},
+		),
+		// Transform Style
+		// @see https://tailwindcss.com/docs/transform-style
+		"transform-style": {
+			{Class: "transform-3d"},
+			{Class: "transform-flat"},
+		},
+		// Backface Visibility
+		// @see https://tailwindcss.com/docs/backface-visibility
+		"backface": {
+			{Class: "backface-hidden"},
+			{Class: "backface-visible"},
+		},
+		// Rotate
+		// @see https://tailwindcss.com/docs/rotate
+		"rotate": {
+			{Class: "rotate-none"},
+		},
+		"rotate-x": {
+			{Class: "rotate-x", Validator: isInteger},
+			{Class: "rotate-x", Validator: isArbitraryValue},
+		},
+		"rotate-y": {
+			{Class: "rotate-y", Validator: isInteger},
+			{Class: "rotate-y", Validator: isArbitraryValue},
+		},
+		"rotate-z": {
+			{Class: "rotate-z", Validator: isInteger},
+			{Class: "rotate-z", Validator: isArbitraryValue},
+		},
+		// Scale
+		// @see https://tailwindcss.com/docs/scale
+		"scale": {
+			{Class: "scale-none"},
+		},
+		"scale-z": {
+			{Class: "scale-z", Validator: isNumber},
+			{Class: "scale-z", Validator: isArbitraryNumber},
+		},
+		"scale-3d": {
+			{Class: "scale-3d"},
+		},
+		// Translate
+		// @see https://tailwindcss.com/docs/translate
+		"translate": {
+			{Class: "translate-none"},
+			{Class: "translate", Validator: isLength},
+			{Class: "translate", Validator: isArbitraryValue},
+		},
+		"translate-z": {
+			{Class: "translate-z", Validator: isLength},
+			{Class: "translate-z", Validator: isArbitraryValue},
+		},
+		// Mask
+		// @see https://tailwindcss.com/docs/mask-image
+		"mask-clip": append(
+			literals("mask-clip", "border", "padding", "content", "fill", "stroke", "view"),
+			ClassDefinition{Class: "mask-no-clip"},
+		),
+		"mask-origin": literals(
+			"mask-origin",
+			"border", "padding", "content", "fill", "stroke", "view",
+		),
+		"mask-composite": literals("mask", "add", "subtract", "intersect", "exclude"),
+		"mask-mode":      literals("mask", "alpha", "luminance", "match"),
+		"mask-type":      literals("mask-type", "alpha", "luminance"),
+		"mask-size": {
+			{Class: "mask-auto"},
+			{Class: "mask-cover"},
+			{Class: "mask-contain"},
+			{Class: "mask-size", Validator: isArbitraryValue},
+		},
+		"mask-position": append(
+			literals("mask", positions...),
+			ClassDefinition{Class: "mask-position", Validator: isArbitraryValue},
+		),
+		"mask-repeat": {
+			{Class: "mask-repeat"},
+			{Class: "mask-no-repeat"},
+			{Class: "mask-repeat-x"},
+			{Class: "mask-repeat-y"},
+			{Class: "mask-repeat-space"},
+			{Class: "mask-repeat-round"},
+		},
+		"mask-image": {
+			{Class: "mask-none"},
+			{Class: "mask", Validator: isArbitraryValue},
+		},
+		"mask-image-linear": {
+			{Class: "mask-linear", Validator: isInteger},
+			{Class: "mask-linear", Validator: isArbitraryValue},
+		},
+		"mask-image-linear-from": {{Class: "mask-linear-from", Validator: isAny}},
+		"mask-image-linear-to":   {{Class: "mask-linear-to", Validator: isAny}},
+		"mask-image-radial": {
+			{Class: "mask-radial"},
+			{Class: "mask-radial", Validator: isArbitraryValue},
+		},
+		"mask-image-radial-shape": {
+			{Class: "mask-circle"},
+			{Class: "mask-ellipse"},
+		},
+		"mask-image-radial-size": literals(
+			"mask-radial",
+			"closest-side", "closest-corner", "farthest-side", "farthest-corner",
+		),
+		"mask-image-radial-pos": append(
+			literals("mask-radial-at", positions...),
+			ClassDefinition{Class: "mask-radial-at", Validator: isArbitraryValue},
+		),
+		"mask-image-radial-from": {{Class: "mask-radial-from", Validator: isAny}},
+		"mask-image-radial-to":   {{Class: "mask-radial-to", Validator: isAny}},
+		"mask-image-conic": {
+			{Class: "mask-conic", Validator: isInteger},
+			{Class: "mask-conic", Validator: isArbitraryValue},
+		},
+		"mask-image-conic-from": {{Class: "mask-conic-from", Validator: isAny}},
+		"mask-image-conic-to":   {{Class: "mask-conic-to", Validator: isAny}},
+		"mask-image-t-from":     {{Class: "mask-t-from", Validator: isAny}},
+		"mask-image-t-to":       {{Class: "mask-t-to", Validator: isAny}},
+		"mask-image-r-from":     {{Class: "mask-r-from", Validator: isAny}},
+		"mask-image-r-to":       {{Class: "mask-r-to", Validator: isAny}},
+		"mask-image-b-from":     {{Class: "mask-b-from", Validator: isAny}},
+		"mask-image-b-to":       {{Class: "mask-b-to", Validator: isAny}},
+		"mask-image-l-from":     {{Class: "mask-l-from", Validator: isAny}},
+		"mask-image-l-to":       {{Class: "mask-l-to", Validator: isAny}},
+		"mask-image-x-from":     {{Class: "mask-x-from", Validator: isAny}},
+		"mask-image-x-to":       {{Class: "mask-x-to", Validator: isAny}},
+		"mask-image-y-from":     {{Class: "mask-y-from", Validator: isAny}},
+		"mask-image-y-to":       {{Class: "mask-y-to", Validator: isAny}},
+	}
+}
+
+// positions are the named positions used by the *-position utilities.
+var positions = []string{
+	"center",
+	"top", "top-left", "top-right",
+	"bottom", "bottom-left", "bottom-right",
+	"left", "right",
+}
+
+// literals returns a class definition for every value after the prefix.
+func literals(prefix string, values ...string) []ClassDefinition {
+	defs := make([]ClassDefinition, 0, len(values))
+	for _, value := range values {
+		defs = append(defs, ClassDefinition{Class: prefix + "-" + value})
+	}
+	return defs
+}
@@ -0,0 +1,104 @@
+package twerge
+
+import "testing"
+
+func TestConfigV4(t *testing.T) {
+	testMerges(t, New(NewHandler(DefaultConfigFor(V4))), []mergeTest{
+		{
+			in:  "shadow-xs shadow-sm",
+			out: "shadow-sm",
+		}, {
+			in:  "shadow-2xs shadow-red-500 shadow-lg",
+			out: "shadow-red-500 shadow-lg",
+		}, {
+			in:  "rounded-xs rounded-md",
+			out: "rounded-md",
+		}, {
+			in:  "inset-shadow-xs inset-shadow-sm inset-shadow-red-500",
+			out: "inset-shadow-sm inset-shadow-red-500",
+		}, {
+			in:  "inset-ring inset-ring-2 inset-ring-blue-500 inset-ring-red-500",
+			out: "inset-ring-2 inset-ring-red-500",
+		}, {
+			in:  "text-shadow-lg text-red-500 text-shadow-sm text-shadow-blue-500",
+			out: "text-red-500 text-shadow-sm text-shadow-blue-500",
+		}, {
+			in:  "field-sizing-fixed field-sizing-content",
+			out: "field-sizing-content",
+		}, {
+			in:  "mask-none mask-[url(/img.png)]",
+			out: "mask-[url(/img.png)]",
+		}, {
+			in:  "mask-x-from-10% mask-l-from-20% mask-r-from-30% mask-x-from-50%",
+			out: "mask-x-from-50%",
+		}, {
+			in:  "mask-t-from-10% mask-b-to-90% mask-center mask-top",
+			out: "mask-t-from-10% mask-b-to-90% mask-top",
+		}, {
+			in:  "perspective-near perspective-dramatic perspective-origin-top",
+			out: "perspective-dramatic perspective-origin-top",
+		}, {
+			in:  "transform-3d transform-flat",
+			out: "transform-flat",
+		}, {
+			in:  "rotate-x-45 rotate-x-12 rotate-y-12 rotate-45",
+			out: "rotate-x-12 rotate-y-12 rotate-45",
+		}, {
+			in:  "translate-x-2 translate-y-2 translate-4",
+			out: "translate-4",
+		}, {
+			in:  "bg-linear-to-r bg-gradient-to-l bg-radial bg-conic-45",
+			out: "bg-conic-45",
+		}, {
+			in:  "bg-red-500 bg-linear-45",
+			out: "bg-red-500 bg-linear-45",
+		}, {
+			in:  "scheme-dark scheme-light",
+			out: "scheme-light",
+		}, {
+			in:  "font-stretch-50% font-bold font-stretch-condensed",
+			out: "font-bold font-stretch-condensed",
+		}, {
+			in:  "outline-none outline-hidden",
+			out: "outline-hidden",
+		}, {
+			// text-lg only overrides the line-height with a postfix
+			in:  "leading-5 text-lg",
+			out: "leading-5 text-lg",
+		}, {
+			in:  "leading-5 text-lg/7",
+			out: "text-lg/7",
+		}, {
+			in:  "@container @container-normal",
+			out: "@container-normal",
+		}, {
+			in:  "@container/main @container/sidebar",
+			out: "@container/sidebar",
+		}, {
+			in:  "@md:flex @md:grid @max-lg:block",
+			out: "@md:grid @max-lg:block",
+		},
+	})
+	if DefaultConfigFor(V4).Version != V4 || DefaultConfigFor(V3).Version != V3 {
+		t.Error("DefaultConfigFor() should set the version")
+	}
+}
+
+func TestConfigVersion(t *testing.T) {
+	cfg := ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{
+			ClassGroups: map[string][]ClassDefinition{"tab": {{Class: "tab-2"}, {Class: "tab-4"}}},
+		},
+	})
+	cfg.Version = V4
+	g := New(NewHandler(cfg))
+	if got := g.Merge("inset-shadow-sm inset-shadow-md"); got != "inset-shadow-md" {
+		t.Errorf("Merge() = %s, wanted the v4 class groups", got)
+	}
+	if got := g.Merge("tab-2 tab-4"); got != "tab-4" {
+		t.Errorf("Merge() = %s, wanted the class groups of the config", got)
+	}
+	if got := New(NewHandler(DefaultConfig())).Merge("inset-shadow-sm inset-shadow-md"); got != "inset-shadow-sm inset-shadow-md" {
+		t.Errorf("Merge() = %s, wanted the v3 class groups", got)
+	}
+}
@@ -0,0 +1,346 @@
+package twerge
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+// LoadCSS reads a Tailwind CSS v4 stylesheet, like the input.css written by
+// [CodeGen], and returns base extended with its @theme, @utility and
+// @custom-variant declarations.
+//
+// If base is nil, the default configuration is used, and a base whose
+// Version is V4 gets the class groups of v4 like in [NewHandler].
+func LoadCSS(base *Config, path string) (*Config, error) {
+	css, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading css file: %w", err)
+	}
+	ext, err := ParseCSS(string(css))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return MergeConfig(baseConfig(base), ext), nil
+}
+
+// ParseCSS returns the @theme, @utility and @custom-variant declarations of
+// a Tailwind CSS v4 stylesheet as a [ConfigExtension].
+//
+//   - @theme variables become theme tokens -> --color-brand-500 is the
+//     brand-500 token of ThemeColor
+//   - @utility rules become class groups, utilities that set the same css
+//     properties conflict -> @utility tab-* { tab-size: ... }, and so do
+//     the arbitrary properties setting them -> [tab-size:8]
+//   - @custom-variant rules become known variants
+func ParseCSS(css string) (ConfigExtension, error) {
+	rules, err := parseCSSRules(css)
+	if err != nil {
+		return ConfigExtension{}, err
+	}
+
+	var (
+		ext       ConfigExtension
+		variables []string
+		// theme namespaces, the namespaces used by functional utilities
+		// are added to them
+		namespaces = slices.Collect(maps.Keys(themeClasses))
+	)
+	ext.Extend.ClassGroups = make(map[string][]ClassDefinition)
+	ext.Extend.Properties = make(ClassGroupProperties)
+	for _, rule := range rules {
+		name, params, _ := strings.Cut(rule.prelude, " ")
+		params = strings.TrimSpace(params)
+		switch name {
+		case "@theme":
+			for _, decl := range rule.declarations() {
+				variables = append(variables, decl.property)
+			}
+		case "@utility":
+			decls := rule.declarations()
+			groupID, defs, used := parseUtility(params, decls)
+			if groupID == "" {
+				continue
+			}
+			ext.Extend.ClassGroups[groupID] = append(ext.Extend.ClassGroups[groupID], defs...)
+			if properties := standardProperties(utilityProperties(decls)); properties != nil {
+				ext.Extend.Properties[groupID] = properties
+			}
+			namespaces = append(namespaces, used...)
+		case "@custom-variant":
+			variant, _, _ := strings.Cut(params, " ")
+			if variant != "" && !slices.Contains(ext.Extend.Variants, variant) {
+				ext.Extend.Variants = append(ext.Extend.Variants, variant)
+			}
+		}
+	}
+
+	ext.Extend.Theme = make(Theme)
+	for _, variable := range variables {
+		namespace, token := cutNamespace(variable, namespaces)
+		if namespace != "" && !slices.Contains(ext.Extend.Theme[namespace], token) {
+			ext.Extend.Theme[namespace] = append(ext.Extend.Theme[namespace], token)
+		}
+	}
+	return ext, nil
+}
+
+// cutNamespace splits a theme variable into the longest of the namespaces
+// and its token -> --color-brand-500 is brand-500 of color.
+//
+// Resets like --color-*: initial and the options of a token like
+// --text-display--line-height are skipped.
+func cutNamespace(variable string, namespaces []string) (namespace, token string) {
+	name, ok := strings.CutPrefix(variable, "--")
+	if !ok || strings.Contains(name, "--") || strings.Contains(name, "*") {
+		return "", ""
+	}
+	for _, candidate := range namespaces {
+		rest, ok := strings.CutPrefix(name, candidate+"-")
+		if ok && rest != "" && len(candidate) > len(namespace) {
+			namespace, token = candidate, rest
+		}
+	}
+	return namespace, token
+}
+
+// parseUtility returns the class group and class definitions of an
+// @utility rule, along with the theme namespaces its value comes from.
+//
+// The class group is named after the css properties the utility sets, so
+// that utilities setting the same properties conflict.
+func parseUtility(
+	name string,
+	decls []cssDeclaration,
+) (groupID string, defs []ClassDefinition, namespaces []string) {
+	properties := utilityProperties(decls)
+	if name == "" || len(properties) == 0 {
+		return "", nil, nil
+	}
+	groupID = utilityGroupID(properties)
+
+	class, functional := strings.CutSuffix(name, "-*")
+	if !functional {
+		return groupID, []ClassDefinition{{Class: name}}, nil
+	}
+	for _, decl := range decls {
+		for _, arg := range valueArgs(decl.value) {
+			if namespace, ok := strings.CutSuffix(arg, "-*"); ok &&
+				strings.HasPrefix(namespace, "--") {
+				namespace = namespace[2:]
+				namespaces = append(namespaces, namespace)
+				defs = append(defs, ClassDefinition{Class: class, Theme: namespace})
+				continue
+			}
+			validator := valueValidators[arg]
+			if strings.HasPrefix(arg, "[") {
+				validator = isArbitraryValue
+			}
+			if validator != nil {
+				defs = append(defs, ClassDefinition{Class: class, Validator: validator})
+			}
+		}
+	}
+	if len(defs) == 0 {
+		defs = append(defs, ClassDefinition{Class: class, Validator: isAny})
+	}
+	return groupID, defs, namespaces
+}
+
+// utilityProperties returns the sorted css properties of the declarations.
+func utilityProperties(decls []cssDeclaration) []string {
+	var properties []string
+	for _, decl := range decls {
+		if !slices.Contains(properties, decl.property) {
+			properties = append(properties, decl.property)
+		}
+	}
+	slices.Sort(properties)
+	return properties
+}
+
+// standardProperties returns the properties without the custom properties
+// like --tw-shadow, nil if there are none.
+func standardProperties(properties []string) []string {
+	var standard []string
+	for _, property := range properties {
+		if !strings.HasPrefix(property, "--") {
+			standard = append(standard, property)
+		}
+	}
+	return standard
+}
+
+// valueValidators are the validators of the data types of --value(),
+// arbitrary values like [length] are matched by isArbitraryValue.
+var valueValidators = map[string]func(string) bool{
+	"integer":    isInteger,
+	"number":     isNumber,
+	"percentage": isPercent,
+}
+
+// valueArgs returns the arguments of the --value() functions of a css
+// value -> integer and --tab-size-* of --value(integer, --tab-size-*).
+func valueArgs(value string) []string {
+	var args []string
+	for {
+		_, rest, ok := strings.Cut(value, "--value(")
+		if !ok {
+			return args
+		}
+		inner, after, _ := strings.Cut(rest, ")")
+		for arg := range strings.SplitSeq(inner, ",") {
+			args = append(args, strings.TrimSpace(arg))
+		}
+		value = after
+	}
+}
+
+// cssRule is a top level rule of a stylesheet, it is not a block for
+// statements like @custom-variant dark (&:where(.dark, .dark *));
+type cssRule struct {
+	prelude string
+	body    string
+	block   bool
+}
+
+type cssDeclaration struct {
+	property string
+	value    string
+}
+
+var errUnexpectedEOF = errors.New("unexpected end of css")
+
+// parseCSSRules returns the top level rules of a stylesheet.
+func parseCSSRules(css string) ([]cssRule, error) {
+	css, err := stripComments(css)
+	if err != nil {
+		return nil, err
+	}
+	var rules []cssRule
+	for {
+		css = strings.TrimSpace(css)
+		if css == "" {
+			return rules, nil
+		}
+		end := indexOutsideQuotes(css, "{;}")
+		if end == -1 {
+			// the last declaration of a block does not need a ;
+			return append(rules, cssRule{prelude: strings.Join(strings.Fields(css), " ")}), nil
+		}
+		rule := cssRule{prelude: strings.Join(strings.Fields(css[:end]), " ")}
+		switch css[end] {
+		case '}':
+			return nil, fmt.Errorf("unexpected } after %q", rule.prelude)
+		case ';':
+			css = css[end+1:]
+		case '{':
+			closing, err := matchBrace(css, end)
+			if err != nil {
+				return nil, err
+			}
+			rule.body = css[end+1 : closing]
+			rule.block = true
+			css = css[closing+1:]
+		}
+		rules = append(rules, rule)
+	}
+}
+
+// declarations returns the top level declarations of the rule, nested
+// rules like &:hover { ... } are skipped.
+func (r cssRule) declarations() []cssDeclaration {
+	var decls []cssDeclaration
+	// the body was matched, so it is balanced
+	nested, _ := parseCSSRules(r.body)
+	for _, rule := range nested {
+		if rule.block {
+			continue
+		}
+		property, value, ok := strings.Cut(rule.prelude, ":")
+		if !ok {
+			continue
+		}
+		decls = append(decls, cssDeclaration{
+			property: strings.TrimSpace(property),
+			value:    strings.TrimSpace(value),
+		})
+	}
+	return decls
+}
+
+// stripComments removes the /* */ comments outside of strings.
+func stripComments(css string) (string, error) {
+	var (
+		b     strings.Builder
+		quote byte
+	)
+	for i := 0; i < len(css); i++ {
+		char := css[i]
+		switch {
+		case quote != 0 && char == '\\' && i+1 < len(css):
+			b.WriteByte(char)
+			i++
+			char = css[i]
+		case quote != 0 && char == quote:
+			quote = 0
+		case quote != 0:
+		case char == '"' || char == '\'':
+			quote = char
+		case strings.HasPrefix(css[i:], "/*"):
+			end := strings.Index(css[i+2:], "*/")
+			if end == -1 {
+				return "", errUnexpectedEOF
+			}
+			i += 2 + end + 1
+			char = ' '
+		}
+		b.WriteByte(char)
+	}
+	return b.String(), nil
+}
+
+// matchBrace returns the index of the } closing the { at open.
+func matchBrace(css string, open int) (int, error) {
+	depth := 0
+	for i := open; ; {
+		next := indexOutsideQuotes(css[i:], "{}")
+		if next == -1 {
+			return -1, errUnexpectedEOF
+		}
+		i += next
+		if css[i] == '{' {
+			depth++
+		} else {
+			depth--
+		}
+		if depth == 0 {
+			return i, nil
+		}
+		i++
+	}
+}
+
+// indexOutsideQuotes returns the index of the first of the chars that is
+// not inside a string, or -1.
+func indexOutsideQuotes(css string, chars string) int {
+	var quote byte
+	for i := 0; i < len(css); i++ {
+		switch {
+		case quote != 0 && css[i] == '\\':
+			i++
+		case quote != 0:
+			if css[i] == quote {
+				quote = 0
+			}
+		case css[i] == '"' || css[i] == '\'':
+			quote = css[i]
+		case strings.IndexByte(chars, css[i]) != -1:
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,174 @@
+package twerge
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+const testCSS = `@import "tailwindcss";
+
+/* design tokens; the "}" in this comment is ignored */
+@theme {
+	--color-*: initial;
+	--color-brand-500: oklch(0.6 0.2 250);
+	--color-primary: #0af;
+	--text-display: 3rem;
+	--text-display--line-height: 1.1;
+	--font-weight-heavy: 900;
+	--spacing-gutter: 1.5rem;
+	--tab-size-github: 8;
+	@keyframes wiggle {
+		0%, 100% { transform: rotate(-3deg); }
+	}
+	--animate-wiggle: wiggle 1s ease-in-out infinite
+}
+
+@utility content-auto {
+	content-visibility: auto;
+}
+
+@utility content-hidden {
+	content-visibility: hidden;
+	&:hover {
+		content-visibility: visible;
+	}
+}
+
+@utility tab-* {
+	tab-size: --value(integer, --tab-size-*);
+}
+
+@custom-variant theme-midnight (&:where([data-theme="midnight"] *));
+@custom-variant pointer-coarse {
+	@media (pointer: coarse) {
+		@slot;
+	}
+}
+
+/* twerge:begin */
+.tw-0 {
+	@apply p-4;
+}
+/* twerge:end */
+`
+
+func TestParseCSS(t *testing.T) {
+	ext, err := ParseCSS(testCSS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTheme := Theme{
+		ThemeColor:      {"brand-500", "primary"},
+		ThemeText:       {"display"},
+		ThemeFontWeight: {"heavy"},
+		ThemeSpacing:    {"gutter"},
+		ThemeAnimate:    {"wiggle"},
+		"tab-size":      {"github"},
+	}
+	if !reflect.DeepEqual(ext.Extend.Theme, wantTheme) {
+		t.Errorf("Theme = %v, want %v", ext.Extend.Theme, wantTheme)
+	}
+	wantVariants := []string{"theme-midnight", "pointer-coarse"}
+	if !slices.Equal(ext.Extend.Variants, wantVariants) {
+		t.Errorf("Variants = %v, want %v", ext.Extend.Variants, wantVariants)
+	}
+	for _, groupID := range []string{"utility.content-visibility", "utility.tab-size"} {
+		if _, ok := ext.Extend.ClassGroups[groupID]; !ok {
+			t.Errorf("missing class group %s in %v", groupID, ext.Extend.ClassGroups)
+		}
+	}
+
+	if _, err := ParseCSS("@theme { --color-brand: red;"); err == nil {
+		t.Error("ParseCSS() should fail for an unclosed block")
+	}
+	if _, err := ParseCSS("@theme { } /* unclosed"); err == nil {
+		t.Error("ParseCSS() should fail for an unclosed comment")
+	}
+}
+
+func TestLoadCSS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.css")
+	if err := os.WriteFile(path, []byte(testCSS), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadCSS(nil, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := New(NewHandler(cfg))
+	// @theme tokens pick the class group, @utility rules make their own
+	testGroupOf(t, g, map[string]string{
+		"text-display":   "font-size",
+		"text-primary":   "text-color",
+		"font-heavy":     "font-weight",
+		"p-gutter":       "p",
+		"animate-wiggle": "animate",
+		"content-auto":   "utility.content-visibility",
+		"tab-4":          "utility.tab-size",
+		"tab-github":     "utility.tab-size",
+	})
+	testMerges(t, g, []mergeTest{
+		{in: "content-auto content-hidden", out: "content-hidden"},
+		{in: "tab-4 [tab-size:8]", out: "[tab-size:8]"},
+	})
+	if issues := g.Validate("theme-midnight:p-2 pointer-coarse:tab-github"); len(issues) != 0 {
+		t.Errorf("Validate() = %v, wanted the custom variants to be known", issues)
+	}
+
+	if _, err := LoadCSS(nil, filepath.Join(t.TempDir(), "missing.css")); err == nil {
+		t.Error("LoadCSS() should fail for a missing file")
+	}
+}
+
+func TestCutNamespace(t *testing.T) {
+	namespaces := []string{"color", "text", "text-shadow"}
+	tt := []struct {
+		variable  string
+		namespace string
+		token     string
+	}{
+		{"--color-brand-500", "color", "brand-500"},
+		{"--text-shadow-soft", "text-shadow", "soft"},
+		{"--text-display", "text", "display"},
+		{"--text-display--line-height", "", ""},
+		{"--color-*", "", ""},
+		{"--spacing-gutter", "", ""},
+		{"color-brand", "", ""},
+	}
+	for _, tc := range tt {
+		namespace, token := cutNamespace(tc.variable, namespaces)
+		if namespace != tc.namespace || token != tc.token {
+			t.Errorf("cutNamespace(%s) = %q %q, wanted %q %q", tc.variable, namespace, token, tc.namespace, tc.token)
+		}
+	}
+}
+
+func TestValueArgs(t *testing.T) {
+	got := valueArgs("calc(--value(integer, --tab-size-*) * 1px) --value([length])")
+	if want := []string{"integer", "--tab-size-*", "[length]"}; !slices.Equal(got, want) {
+		t.Errorf("valueArgs() = %v, wanted %v", got, want)
+	}
+}
+
+func TestLoadCSSBase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.css")
+	if err := os.WriteFile(path, []byte(testCSS), 0644); err != nil {
+		t.Fatal(err)
+	}
+	base := MergeConfig(DefaultConfigFor(V4), DaisyUIPlugin())
+	base.Prefix = "tw:"
+	cfg, err := LoadCSS(base, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := New(NewHandler(cfg))
+	if got := g.Merge("tw:btn-primary tw:btn-secondary tw:tab-4 tw:tab-github"); got != "tw:btn-secondary tw:tab-github" {
+		t.Errorf("Merge() = %s, wanted the plugin and the utilities of the stylesheet", got)
+	}
+	if base.Prefix != "tw:" || len(base.Theme[ThemeColor]) == 0 {
+		t.Errorf("LoadCSS() should not modify base")
+	}
+}
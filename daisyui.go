@@ -0,0 +1,149 @@
+package twerge
+
+// DaisyUIPlugin returns the class groups of the daisyUI components, so
+// that the colors, sizes and styles of a component override each other ->
+// btn-primary and btn-secondary.
+//
+// The semantic colors of daisyUI are added to the theme, so that
+// bg-primary and bg-base-200 are colors.
+//
+// Example: ExtendConfig(DaisyUIPlugin())
+func DaisyUIPlugin() ConfigExtension {
+	groups := make(map[string][]ClassDefinition)
+	add := func(component, group string, values ...string) {
+		// one dot is the prefix of the class groups of plugins
+		groupID := "daisyui." + component + "-" + group
+		groups[groupID] = append(groups[groupID], literals(component, values...)...)
+	}
+	for _, component := range []string{
+		"btn", "badge", "input", "select", "textarea", "file-input",
+		"checkbox", "radio", "toggle", "range", "status",
+	} {
+		add(component, "color", daisyColors...)
+		add(component, "size", daisySizes...)
+	}
+	for _, component := range []string{
+		"progress", "link", "tooltip", "divider", "chat-bubble", "step",
+	} {
+		add(component, "color", daisyColors...)
+	}
+	for _, component := range []string{"loading", "kbd", "menu", "tabs", "table"} {
+		add(component, "size", daisySizes...)
+	}
+	for _, component := range []string{"menu", "join", "stats", "divider", "steps", "alert"} {
+		add(component, "direction", "vertical", "horizontal")
+	}
+
+	add("btn", "style", "outline", "dash", "soft", "ghost", "link")
+	add("btn", "shape", "square", "circle")
+	add("btn", "width", "wide", "block")
+	add("badge", "style", "outline", "dash", "soft", "ghost")
+	add("alert", "color", daisyStates...)
+	add("alert", "style", "outline", "dash", "soft")
+	for _, component := range []string{"input", "select", "textarea", "file-input"} {
+		add(component, "style", "bordered", "ghost")
+	}
+	add("loading", "style", "spinner", "dots", "ring", "ball", "bars", "infinity")
+	add("card", "style", "bordered", "border", "dash")
+	add("card", "size", "compact", "normal")
+	add("card", "size", daisySizes...)
+	add("tabs", "style", "box", "border", "lift", "boxed", "bordered", "lifted")
+	add("modal", "y", "top", "middle", "bottom")
+	add("modal", "x", "start", "end")
+	add("tooltip", "placement", "top", "bottom", "left", "right")
+	add("dropdown", "placement", "top", "bottom", "left", "right")
+	add("dropdown", "align", "start", "center", "end")
+	add("toast", "x", "start", "center", "end")
+	add("toast", "y", "top", "middle", "bottom")
+	add("divider", "placement", "start", "end")
+	add("chat", "placement", "start", "end")
+
+	// the component classes and their parts, states and options that do
+	// not exclude each other are class groups of their own, so that they
+	// are known classes -> btn btn-active
+	for _, component := range daisyComponents {
+		groups["daisyui."+component] = []ClassDefinition{{Class: component}}
+	}
+	for component, parts := range daisyParts {
+		for _, part := range parts {
+			add(component, part, part)
+		}
+	}
+	add("dropdown", "state", "open", "close")
+	add("collapse", "state", "open", "close")
+	add("collapse", "icon", "arrow", "plus")
+	add("swap", "effect", "rotate", "flip")
+	add("avatar", "presence", "online", "offline")
+	add("indicator", "x", "start", "center", "end")
+	add("indicator", "y", "top", "middle", "bottom")
+	add("carousel", "snap", "start", "center", "end")
+	add("carousel", "direction", "vertical", "horizontal")
+	add("timeline", "direction", "vertical", "horizontal")
+	add("tabs", "placement", "top", "bottom")
+	add("footer", "direction", "vertical", "horizontal")
+
+	colors := make([]string, 0, 2*len(daisyColors)+4)
+	for _, color := range daisyColors {
+		colors = append(colors, color, color+"-content")
+	}
+	colors = append(colors, "base-100", "base-200", "base-300", "base-content")
+	return ConfigExtension{Extend: ConfigGroups{
+		ClassGroups: groups,
+		Theme:       Theme{ThemeColor: colors},
+	}}
+}
+
+var (
+	// daisyColors are the semantic colors of daisyUI.
+	daisyColors = []string{
+		"neutral", "primary", "secondary", "accent",
+		"info", "success", "warning", "error",
+	}
+	// daisyStates are the colors of the components that report a state.
+	daisyStates = []string{"info", "success", "warning", "error"}
+	daisySizes  = []string{"xs", "sm", "md", "lg", "xl"}
+
+	// daisyComponents are the classes of the daisyUI components, but
+	// collapse, filter and table that are Tailwind classes.
+	daisyComponents = []string{
+		"alert", "avatar", "avatar-group", "badge", "breadcrumbs", "btn",
+		"card", "carousel", "chat", "checkbox", "countdown", "diff", "divider",
+		"dock", "drawer", "dropdown", "fieldset", "file-input", "footer",
+		"hero", "indicator", "input", "join", "kbd", "label", "link", "list",
+		"loading", "menu", "modal", "navbar", "progress", "radial-progress",
+		"radio", "range", "rating", "select", "skeleton", "stack", "stat",
+		"stats", "status", "steps", "step", "swap", "tab", "tabs", "textarea",
+		"timeline", "toast", "toggle", "tooltip", "validator",
+	}
+	// daisyParts are the parts, states and options of the components
+	// -> card-body of card.
+	daisyParts = map[string][]string{
+		"btn":       {"active", "disabled"},
+		"card":      {"body", "title", "actions", "side"},
+		"carousel":  {"item"},
+		"chat":      {"image", "header", "footer", "bubble"},
+		"collapse":  {"title", "content"},
+		"diff":      {"item-1", "item-2", "resizer"},
+		"drawer":    {"toggle", "content", "side", "overlay", "open", "end"},
+		"dropdown":  {"content", "hover"},
+		"fieldset":  {"legend"},
+		"footer":    {"title", "center"},
+		"hero":      {"content", "overlay"},
+		"indicator": {"item"},
+		"join":      {"item"},
+		"link":      {"hover"},
+		"list":      {"row"},
+		"avatar":    {"placeholder"},
+		"menu":      {"title", "active", "disabled", "focus", "dropdown", "dropdown-toggle"},
+		"modal":     {"box", "action", "backdrop", "toggle", "open"},
+		"navbar":    {"start", "center", "end"},
+		"rating":    {"half", "hidden"},
+		"skeleton":  {"text"},
+		"stat":      {"title", "value", "desc", "figure", "actions"},
+		"swap":      {"on", "off", "indeterminate", "active"},
+		"tab":       {"active", "disabled", "content"},
+		"timeline":  {"start", "middle", "end", "compact", "snap-icon"},
+		"tooltip":   {"content", "open"},
+		"validator": {"hint"},
+	}
+)
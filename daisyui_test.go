@@ -0,0 +1,59 @@
+package twerge
+
+import "testing"
+
+func TestDaisyUIPlugin(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(DaisyUIPlugin())))
+	// the modifiers of every component are class groups of the component
+	testGroupOf(t, g, map[string]string{
+		"btn":           "daisyui.btn",
+		"btn-primary":   "daisyui.btn-color",
+		"btn-lg":        "daisyui.btn-size",
+		"btn-ghost":     "daisyui.btn-style",
+		"btn-circle":    "daisyui.btn-shape",
+		"badge-primary": "daisyui.badge-color",
+		"alert-warning": "daisyui.alert-color",
+		"card-body":     "daisyui.card-body",
+		"modal-bottom":  "daisyui.modal-y",
+		"modal-start":   "daisyui.modal-x",
+		"table-lg":      "daisyui.table-size",
+		// Tailwind classes sharing a prefix with a component keep their
+		// class group
+		"select-none": "select",
+		"table":       "display",
+		"collapse":    "visibility",
+		// the semantic colors are theme colors
+		"bg-base-200":          "bg-color",
+		"text-primary-content": "text-color",
+	})
+	// alert has no primary color
+	if got := g.GroupOf("alert-primary"); got != "" {
+		t.Errorf("GroupOf(alert-primary) = %q, wanted none", got)
+	}
+	testMerges(t, g, []mergeTest{
+		{in: "btn-primary badge-secondary", out: "btn-primary badge-secondary"},
+		{in: "select select-none select-primary select-error", out: "select select-none select-error"},
+	})
+}
+
+func TestDaisyUIPluginValidate(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(DaisyUIPlugin())))
+	for _, classes := range []string{
+		"btn btn-primary btn-sm btn-active hover:btn-secondary",
+		"card card-border bg-base-100 shadow-sm",
+		"card-body card-title card-actions justify-end",
+		"navbar bg-base-100 navbar-start navbar-center navbar-end",
+		"modal modal-open modal-bottom sm:modal-middle modal-box modal-action",
+		"badge badge-outline alert alert-info alert-soft",
+		"dropdown dropdown-end dropdown-open dropdown-content menu menu-sm menu-active",
+		"input input-bordered input-primary w-full max-w-xs",
+		"tabs tabs-box tab tab-active text-base-content",
+	} {
+		if issues := g.Validate(classes); len(issues) != 0 {
+			t.Errorf("Validate(%q) = %v, wanted no issues", classes, issues)
+		}
+	}
+	if got := g.Merge("dropdown-open dropdown-close collapse-arrow collapse-plus btn-active btn-disabled"); got != "dropdown-close collapse-plus btn-active btn-disabled" {
+		t.Errorf("Merge() = %s, wanted the states to override each other", got)
+	}
+}
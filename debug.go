@@ -24,6 +24,9 @@ func NewDebugHandler() *DebugHandler {
 // It returns a short unique CSS class name from the merged classes.
 func (d *DebugHandler) It(s string) string { return s }
 
+// Merge returns the classes unchanged.
+func (d *DebugHandler) Merge(s string) string { return s }
+
 // Cache returns the cache of the [Generator].
 func (d *DebugHandler) Cache() map[string]CacheValue {
 	d.mu.RLock()
@@ -75,10 +75,38 @@
 //	// This is the most commonly used function in templates.
 //	func It(raw string) string
 //
+//	// Merge returns the merged classes without generating a short class name.
+//	// Useful when relying on Tailwind's own content scanning.
+//	func Merge(classes ...string) string
+//
+//	// Join returns a short unique CSS class name from the merged parts,
+//	// skipping empty parts, later parts win conflicts.
+//	// Useful for components taking classes that override their own.
+//	func Join(parts ...string) string
+//
 //	// If returns a class based on a condition.
 //	// Useful for conditional styling.
 //	func If(ok bool, trueClass string, falseClass string) string
 //
+//	// Validate reports unknown classes and variants, malformed arbitrary
+//	// values and overridden classes.
+//	func Validate(classes string) []Issue
+//
+//	// Sort returns the classes in the canonical order of Tailwind CSS.
+//	func Sort(classes string) string
+//
+//	// ParseClass splits a class into its variants, important modifier,
+//	// base, postfix and arbitrary value, ParseClasses splits every class.
+//	func ParseClass(token string) (Class, error)
+//	func ParseClasses(s string) []Class
+//
+//	// GroupOf, ConflictsOf and Groups describe the class groups of the
+//	// config, Graph exports their conflicts as JSON or DOT.
+//	func GroupOf(class string) string
+//	func ConflictsOf(groupID string) []string
+//	func Groups() []string
+//	func Graph() ConflictGraph
+//
 //	// CodeGen generates all the code needed to use Twerge statically.
 //	func CodeGen(g *Generator, goPath string, cssPath string, htmlPath string, comps ...templ.Component) error
 //
@@ -90,14 +118,21 @@
 //	func Default() *Generator
 //
 //	// New creates a new Generator with the given non-nil Handler.
-//	func New(h Handler) *Generator
+//	// WithValidation makes CodeGen fail on invalid classes, WithShorthands
+//	// collapses longhands like px-2 py-2 into p-2 and
+//	// WithoutRedundantVariants drops sm:p-4 of p-4 sm:p-4, WithSortedClasses
+//	// stores the merged classes in canonical order.
+//	func New(h Handler, opts ...Option) *Generator
 //
 //	// Cache returns the cache of the Generator.
-//	func (Generator) Cache() map[string]CacheValue
+//	func (g *Generator) Cache() map[string]CacheValue
 //
 //	// It returns a short unique CSS class name from the merged classes.
 //	func (g *Generator) It(classes string) string
 //
+//	// Merge returns the merged classes without generating a short class name.
+//	func (g *Generator) Merge(classes ...string) string
+//
 // ## Configuration
 //
 // Although most users will use the default configuration, customization is possible
@@ -123,7 +158,7 @@
 // 1. Run CodeGen as part of your build process
 // 2. Use twerge.It() for all Tailwind classes in your templates
 // 3. Use twerge.If() for conditional class application
-// 4. Avoid manual string concatenation for class names when possible
+// 4. Use twerge.Join() instead of concatenating class strings
 //
 // # Example Workflow
 //
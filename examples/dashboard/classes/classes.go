@@ -10,11 +10,11 @@ func SetCache() {
 var ClassMapStr = map[string]twerge.CacheValue{
 	"bg-gray-100 text-gray-900 flex flex-col min-h-screen": twerge.CacheValue{
 		Generated: "tw-0",
-		Merged:    "text-gray-900 flex flex-col min-h-screen bg-gray-100",
+		Merged:    "bg-gray-100 text-gray-900 flex flex-col min-h-screen",
 	},
 	"bg-gray-200 text-gray-700 px-4 py-2 rounded-md mr-3 hover:bg-gray-300 transition-colors": twerge.CacheValue{
 		Generated: "tw-42",
-		Merged:    "py-2 px-4 mr-3 hover:bg-gray-300 transition-colors bg-gray-200 rounded-md text-gray-700",
+		Merged:    "bg-gray-200 text-gray-700 px-4 py-2 rounded-md mr-3 hover:bg-gray-300 transition-colors",
 	},
 	"bg-gray-50": twerge.CacheValue{
 		Generated: "tw-21",
@@ -26,11 +26,11 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"bg-green-100 text-green-800 text-xs font-semibold px-2 py-1 rounded": twerge.CacheValue{
 		Generated: "tw-15",
-		Merged:    "bg-green-100 text-xs py-1 rounded text-green-800 px-2 font-semibold",
+		Merged:    "bg-green-100 text-green-800 text-xs font-semibold px-2 py-1 rounded",
 	},
 	"bg-indigo-600 text-white px-4 py-2 rounded-md hover:bg-indigo-700 transition-colors": twerge.CacheValue{
 		Generated: "tw-43",
-		Merged:    "hover:bg-indigo-700 transition-colors rounded-md py-2 text-white px-4 bg-indigo-600",
+		Merged:    "bg-indigo-600 text-white px-4 py-2 rounded-md hover:bg-indigo-700 transition-colors",
 	},
 	"bg-indigo-700 text-white shadow-lg": twerge.CacheValue{
 		Generated: "tw-1",
@@ -38,39 +38,39 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"bg-red-100 text-red-800 text-xs font-semibold px-2 py-1 rounded": twerge.CacheValue{
 		Generated: "tw-18",
-		Merged:    "px-2 py-1 rounded text-red-800 bg-red-100 text-xs font-semibold",
+		Merged:    "bg-red-100 text-red-800 text-xs font-semibold px-2 py-1 rounded",
 	},
 	"bg-white divide-y divide-gray-200": twerge.CacheValue{
 		Generated: "tw-23",
-		Merged:    "divide-gray-200 bg-white divide-y",
+		Merged:    "bg-white divide-y divide-gray-200",
 	},
 	"bg-white rounded-lg shadow-md overflow-hidden": twerge.CacheValue{
 		Generated: "tw-19",
-		Merged:    "overflow-hidden rounded-lg shadow-md bg-white",
+		Merged:    "bg-white rounded-lg shadow-md overflow-hidden",
 	},
 	"bg-white rounded-lg shadow-md p-6": twerge.CacheValue{
 		Generated: "tw-12",
-		Merged:    "bg-white rounded-lg p-6 shadow-md",
+		Merged:    "bg-white rounded-lg shadow-md p-6",
 	},
 	"block text-sm font-medium text-gray-700 mb-1": twerge.CacheValue{
 		Generated: "tw-35",
-		Merged:    "font-medium text-gray-700 mb-1 block text-sm",
+		Merged:    "block text-sm font-medium text-gray-700 mb-1",
 	},
 	"block w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm focus:outline-none focus:ring-indigo-500 focus:border-indigo-500": twerge.CacheValue{
 		Generated: "tw-36",
-		Merged:    "shadow-sm focus:outline-none border rounded-md border-gray-300 focus:ring-indigo-500 focus:border-indigo-500 block px-3 w-full py-2",
+		Merged:    "block w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm focus:outline-none focus:ring-indigo-500 focus:border-indigo-500",
 	},
 	"container mx-auto px-4 py-3 flex justify-between items-center": twerge.CacheValue{
 		Generated: "tw-2",
-		Merged:    "mx-auto px-4 py-3 flex justify-between items-center container",
+		Merged:    "container mx-auto px-4 py-3 flex justify-between items-center",
 	},
 	"container mx-auto px-4 py-6 flex-grow": twerge.CacheValue{
 		Generated: "tw-8",
-		Merged:    "flex-grow container mx-auto px-4 py-6",
+		Merged:    "container mx-auto px-4 py-6 flex-grow",
 	},
 	"container mx-auto px-4 text-center text-sm": twerge.CacheValue{
 		Generated: "tw-31",
-		Merged:    "px-4 text-center text-sm container mx-auto",
+		Merged:    "container mx-auto px-4 text-center text-sm",
 	},
 	"flex border-b border-gray-200": twerge.CacheValue{
 		Generated: "tw-60",
@@ -94,7 +94,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"flex justify-between items-end mb-4": twerge.CacheValue{
 		Generated: "tw-50",
-		Merged:    "mb-4 flex justify-between items-end",
+		Merged:    "flex justify-between items-end mb-4",
 	},
 	"flex justify-end": twerge.CacheValue{
 		Generated: "tw-41",
@@ -130,7 +130,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"h-4 w-4 text-indigo-600 focus:ring-indigo-500 border-gray-300 rounded": twerge.CacheValue{
 		Generated: "tw-39",
-		Merged:    "h-4 text-indigo-600 border-gray-300 w-4 focus:ring-indigo-500 rounded",
+		Merged:    "h-4 w-4 text-indigo-600 focus:ring-indigo-500 border-gray-300 rounded",
 	},
 	"h-5 w-5": twerge.CacheValue{
 		Generated: "tw-53",
@@ -142,11 +142,11 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"hover:text-indigo-200 transition-colors": twerge.CacheValue{
 		Generated: "tw-7",
-		Merged:    "transition-colors hover:text-indigo-200",
+		Merged:    "hover:text-indigo-200 transition-colors",
 	},
 	"inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-red-100 text-red-800": twerge.CacheValue{
 		Generated: "tw-47",
-		Merged:    "py-0.5 inline-flex font-medium bg-red-100 rounded-full text-xs px-2.5 text-red-800 items-center",
+		Merged:    "inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-red-100 text-red-800",
 	},
 	"mb-6": twerge.CacheValue{
 		Generated: "tw-9",
@@ -162,7 +162,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"ml-3 text-sm text-gray-700": twerge.CacheValue{
 		Generated: "tw-40",
-		Merged:    "text-gray-700 ml-3 text-sm",
+		Merged:    "ml-3 text-sm text-gray-700",
 	},
 	"ml-4 text-sm text-indigo-600 hover:text-indigo-500": twerge.CacheValue{
 		Generated: "tw-48",
@@ -182,51 +182,51 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"px-2 inline-flex text-xs leading-5 font-semibold rounded-full bg-gray-100 text-gray-800": twerge.CacheValue{
 		Generated: "tw-29",
-		Merged:    "text-gray-800 px-2 inline-flex font-semibold bg-gray-100 leading-5 rounded-full text-xs",
+		Merged:    "px-2 inline-flex text-xs leading-5 font-semibold rounded-full bg-gray-100 text-gray-800",
 	},
 	"px-2 inline-flex text-xs leading-5 font-semibold rounded-full bg-green-100 text-green-800": twerge.CacheValue{
 		Generated: "tw-27",
-		Merged:    "bg-green-100 font-semibold inline-flex px-2 text-xs leading-5 rounded-full text-green-800",
+		Merged:    "px-2 inline-flex text-xs leading-5 font-semibold rounded-full bg-green-100 text-green-800",
 	},
 	"px-2 inline-flex text-xs leading-5 font-semibold rounded-full bg-yellow-100 text-yellow-800": twerge.CacheValue{
 		Generated: "tw-28",
-		Merged:    "text-xs leading-5 bg-yellow-100 inline-flex font-semibold rounded-full px-2 text-yellow-800",
+		Merged:    "px-2 inline-flex text-xs leading-5 font-semibold rounded-full bg-yellow-100 text-yellow-800",
 	},
 	"px-3 py-1 border border-gray-300 rounded text-sm text-gray-700 bg-white hover:bg-gray-50": twerge.CacheValue{
 		Generated: "tw-71",
-		Merged:    "text-sm border border-gray-300 px-3 bg-white hover:bg-gray-50 text-gray-700 py-1 rounded",
+		Merged:    "px-3 py-1 border border-gray-300 rounded text-sm text-gray-700 bg-white hover:bg-gray-50",
 	},
 	"px-4 py-3 text-sm font-medium border-b-2 border-indigo-500 text-indigo-600": twerge.CacheValue{
 		Generated: "tw-61",
-		Merged:    "px-4 text-sm font-medium border-indigo-500 border-b-2 text-indigo-600 py-3",
+		Merged:    "px-4 py-3 text-sm font-medium border-b-2 border-indigo-500 text-indigo-600",
 	},
 	"px-4 py-3 text-sm font-medium text-gray-500 hover:text-gray-700": twerge.CacheValue{
 		Generated: "tw-62",
-		Merged:    "px-4 py-3 text-sm text-gray-500 font-medium hover:text-gray-700",
+		Merged:    "px-4 py-3 text-sm font-medium text-gray-500 hover:text-gray-700",
 	},
 	"px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider": twerge.CacheValue{
 		Generated: "tw-22",
-		Merged:    "px-6 text-left text-xs py-3 font-medium text-gray-500 uppercase tracking-wider",
+		Merged:    "px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider",
 	},
 	"px-6 py-3 text-right text-xs font-medium text-gray-500 uppercase tracking-wider": twerge.CacheValue{
 		Generated: "tw-64",
-		Merged:    "py-3 text-right uppercase text-xs font-medium text-gray-500 tracking-wider px-6",
+		Merged:    "px-6 py-3 text-right text-xs font-medium text-gray-500 uppercase tracking-wider",
 	},
 	"px-6 py-4 bg-gray-50 border-t border-gray-200 flex items-center justify-between": twerge.CacheValue{
 		Generated: "tw-69",
-		Merged:    "border-gray-200 py-4 justify-between bg-gray-50 items-center flex px-6 border-t",
+		Merged:    "px-6 py-4 bg-gray-50 border-t border-gray-200 flex items-center justify-between",
 	},
 	"px-6 py-4 whitespace-nowrap text-right text-sm font-medium": twerge.CacheValue{
 		Generated: "tw-66",
-		Merged:    "px-6 py-4 text-right text-sm whitespace-nowrap font-medium",
+		Merged:    "px-6 py-4 whitespace-nowrap text-right text-sm font-medium",
 	},
 	"px-6 py-4 whitespace-nowrap text-sm font-medium text-gray-900": twerge.CacheValue{
 		Generated: "tw-24",
-		Merged:    "py-4 whitespace-nowrap font-medium px-6 text-sm text-gray-900",
+		Merged:    "px-6 py-4 whitespace-nowrap text-sm font-medium text-gray-900",
 	},
 	"px-6 py-4 whitespace-nowrap text-sm text-gray-500": twerge.CacheValue{
 		Generated: "tw-25",
-		Merged:    "px-6 py-4 text-gray-500 whitespace-nowrap text-sm",
+		Merged:    "px-6 py-4 whitespace-nowrap text-sm text-gray-500",
 	},
 	"px-6 py-4 whitespace-nowrap text-sm text-green-500": twerge.CacheValue{
 		Generated: "tw-65",
@@ -262,7 +262,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"text-green-500 flex items-center": twerge.CacheValue{
 		Generated: "tw-52",
-		Merged:    "flex items-center text-green-500",
+		Merged:    "text-green-500 flex items-center",
 	},
 	"text-indigo-300 hover:text-indigo-100": twerge.CacheValue{
 		Generated: "tw-32",
@@ -274,7 +274,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"text-lg font-medium text-gray-800 mb-4": twerge.CacheValue{
 		Generated: "tw-33",
-		Merged:    "font-medium text-gray-800 mb-4 text-lg",
+		Merged:    "text-lg font-medium text-gray-800 mb-4",
 	},
 	"text-lg font-semibold text-gray-800": twerge.CacheValue{
 		Generated: "tw-58",
@@ -290,10 +290,10 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"text-xs text-gray-500": twerge.CacheValue{
 		Generated: "tw-59",
-		Merged:    "text-gray-500 text-xs",
+		Merged:    "text-xs text-gray-500",
 	},
 	"w-full h-full flex items-center justify-center text-gray-400": twerge.CacheValue{
 		Generated: "tw-56",
-		Merged:    "h-full flex items-center justify-center text-gray-400 w-full",
+		Merged:    "w-full h-full flex items-center justify-center text-gray-400",
 	},
 }
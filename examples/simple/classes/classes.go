@@ -10,7 +10,7 @@ func SetCache() {
 var ClassMapStr = map[string]twerge.CacheValue{
 	"bg-gray-50 text-gray-900 flex flex-col min-h-screen": twerge.CacheValue{
 		Generated: "tw-0",
-		Merged:    "flex flex-col min-h-screen bg-gray-50 text-gray-900",
+		Merged:    "bg-gray-50 text-gray-900 flex flex-col min-h-screen",
 	},
 	"bg-gray-800 text-white py-6": twerge.CacheValue{
 		Generated: "tw-9",
@@ -18,23 +18,23 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"bg-indigo-600 text-white shadow-md": twerge.CacheValue{
 		Generated: "tw-1",
-		Merged:    "shadow-md bg-indigo-600 text-white",
+		Merged:    "bg-indigo-600 text-white shadow-md",
 	},
 	"container mx-auto px-4 py-4 flex justify-between items-center": twerge.CacheValue{
 		Generated: "tw-2",
-		Merged:    "px-4 flex items-center mx-auto py-4 justify-between container",
+		Merged:    "container mx-auto px-4 py-4 flex justify-between items-center",
 	},
 	"container mx-auto px-4 py-6 flex-grow": twerge.CacheValue{
 		Generated: "tw-8",
-		Merged:    "flex-grow py-6 container mx-auto px-4",
+		Merged:    "container mx-auto px-4 py-6 flex-grow",
 	},
 	"container mx-auto px-4": twerge.CacheValue{
 		Generated: "tw-10",
-		Merged:    "mx-auto px-4 container",
+		Merged:    "container mx-auto px-4",
 	},
 	"flex flex-col md:flex-row justify-between items-center": twerge.CacheValue{
 		Generated: "tw-11",
-		Merged:    "justify-between items-center flex flex-col md:flex-row",
+		Merged:    "flex flex-col md:flex-row justify-between items-center",
 	},
 	"flex items-center space-x-2": twerge.CacheValue{
 		Generated: "tw-3",
@@ -42,7 +42,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"flex space-x-4": twerge.CacheValue{
 		Generated: "tw-15",
-		Merged:    "space-x-4 flex",
+		Merged:    "flex space-x-4",
 	},
 	"flex space-x-6": twerge.CacheValue{
 		Generated: "tw-6",
@@ -66,7 +66,7 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"text-2xl font-bold": twerge.CacheValue{
 		Generated: "tw-5",
-		Merged:    "font-bold text-2xl",
+		Merged:    "text-2xl font-bold",
 	},
 	"text-gray-400 hover:text-white transition-colors": twerge.CacheValue{
 		Generated: "tw-16",
@@ -78,6 +78,6 @@ var ClassMapStr = map[string]twerge.CacheValue{
 	},
 	"text-xl font-semibold": twerge.CacheValue{
 		Generated: "tw-13",
-		Merged:    "font-semibold text-xl",
+		Merged:    "text-xl font-semibold",
 	},
 }
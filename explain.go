@@ -0,0 +1,49 @@
+package twerge
+
+// Decision explains what the merger decided for a single class.
+type Decision struct {
+	// Class is the class as it appeared in the input.
+	//
+	// Example: hover:!bg-red-500/50
+	Class string
+	// Modifiers are the variants of the class in their original order.
+	//
+	// Example: [hover]
+	Modifiers []string
+	// Important is true if the class has the important modifier.
+	Important bool
+	// Postfix is the postfix modifier of the class without the separator.
+	//
+	// Example: 50
+	Postfix string
+	// GroupID is the class group the class belongs to, empty if the class
+	// is not a Tailwind class.
+	//
+	// Example: bg-color
+	GroupID string
+	// IsTailwind is true if the class was recognized as a Tailwind class.
+	IsTailwind bool
+	// Kept is true if the class is part of the merged classes.
+	Kept bool
+	// OverriddenBy is the index of the later class that removed this one
+	// through its class group or its conflicting class groups, -1 if the
+	// class is kept.
+	OverriddenBy int
+}
+
+// Explain reports for every class why it was kept or dropped when merging.
+//
+// It is meant for tests and debugging wrongly merged classes.
+func Explain(classes string) []Decision {
+	return Default().Explain(classes)
+}
+
+// Explain reports for every class why it was kept or dropped when merging.
+func (g *Generator) Explain(classes string) []Decision {
+	return g.mergeHandler().Explain(classes)
+}
+
+// Explain reports for every class why it was kept or dropped when merging.
+func (g *defaultHandler) Explain(classes string) []Decision {
+	return g.resolve(classes)
+}
@@ -0,0 +1,62 @@
+package twerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	g := New(newDefaultHandler())
+	got := g.Explain("hover:p-2 custom px-4 !text-lg/7 p-3 custom")
+	want := []Decision{
+		{
+			Class:        "hover:p-2",
+			Modifiers:    []string{"hover"},
+			GroupID:      "p",
+			IsTailwind:   true,
+			Kept:         true,
+			OverriddenBy: -1,
+		}, {
+			Class:        "custom",
+			OverriddenBy: 5,
+		}, {
+			Class:        "px-4",
+			GroupID:      "px",
+			IsTailwind:   true,
+			OverriddenBy: 4,
+		}, {
+			Class:        "!text-lg/7",
+			Important:    true,
+			Postfix:      "7",
+			GroupID:      "font-size",
+			IsTailwind:   true,
+			Kept:         true,
+			OverriddenBy: -1,
+		}, {
+			Class:        "p-3",
+			GroupID:      "p",
+			IsTailwind:   true,
+			Kept:         true,
+			OverriddenBy: -1,
+		}, {
+			Class:        "custom",
+			Kept:         true,
+			OverriddenBy: -1,
+		},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Explain() returned %d decisions, wanted %d", len(got), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("decision %d:\n got: %+v\nwant: %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestExplainDebugHandler(t *testing.T) {
+	got := New(NewDebugHandler()).Explain("p-2 p-4")
+	if len(got) != 2 || got[0].Kept || got[0].OverriddenBy != 1 || !got[1].Kept {
+		t.Errorf("Explain() should fall back to the default config: %+v", got)
+	}
+}
@@ -0,0 +1,266 @@
+package twerge
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+type (
+	// ClassDefinition is a single entry of a class group in a
+	// [ConfigExtension].
+	ClassDefinition struct {
+		// Class is the class, or the class prefix when Validator or Theme
+		// is set.
+		//
+		// Example: text-brand
+		Class string
+		// Validator optionally matches the value that follows Class and the
+		// class separator.
+		//
+		// Example: IsAny makes "text-brand" match text-brand-500
+		Validator func(string) bool
+		// Theme optionally matches the tokens of a [Theme] namespace that
+		// follow Class and the class separator, it is ignored when
+		// Validator is set.
+		//
+		// Example: ThemeText makes "text" match text-display when the
+		// theme has a display font size
+		Theme string
+	}
+	// ConfigGroups holds the class groups and conflicts of a
+	// [ConfigExtension].
+	ConfigGroups struct {
+		// ClassGroups maps a class group ID to its class definitions.
+		ClassGroups map[string][]ClassDefinition
+		// ConflictingClassGroups maps a class group ID to the class groups
+		// it overrides.
+		ConflictingClassGroups ConflictingClassGroups
+		// ConflictingClassGroupModifiers maps a class group ID to the class
+		// groups it overrides when it has a postfix modifier.
+		ConflictingClassGroupModifiers ConflictingClassGroups
+		// Variants are known variants, a trailing -* matches by prefix.
+		//
+		// Example: theme-*
+		Variants []string
+		// OrderSensitiveModifiers are the modifiers that can not be
+		// reordered without changing the selector, a trailing -* matches by
+		// prefix.
+		//
+		// Example: prose-a
+		OrderSensitiveModifiers []string
+		// Theme maps a theme namespace to its design tokens.
+		Theme Theme
+		// Properties maps a class group ID to the css properties set by
+		// its classes.
+		Properties ClassGroupProperties
+	}
+	// ConfigExtension describes changes to apply on top of a [Config].
+	ConfigExtension struct {
+		// Override replaces the class groups and conflicts with the same ID.
+		Override ConfigGroups
+		// Extend adds to the class groups and conflicts with the same ID.
+		//
+		// Extended validators are tried before the existing ones. Class
+		// groups are added in the order of their IDs, so a catch-all
+		// validator like IsAny that shares a prefix with another group
+		// belongs in an earlier extension.
+		Extend ConfigGroups
+	}
+)
+
+// IsAny always returns true.
+//
+// The Is* validators can be used in a [ClassDefinition].
+func IsAny(val string) bool { return isAny(val) }
+
+// IsNumber returns true if the value is an integer or a float.
+func IsNumber(val string) bool { return isNumber(val) }
+
+// IsInteger returns true if the value is an integer.
+func IsInteger(val string) bool { return isInteger(val) }
+
+// IsLength returns true if the value is a number, a fraction, px, full or
+// screen.
+func IsLength(val string) bool { return isLength(val) }
+
+// IsPercent returns true if the value is a percentage like 50%.
+func IsPercent(val string) bool { return isPercent(val) }
+
+// IsTshirtSize returns true if the value is a t-shirt size like sm or 2xl.
+func IsTshirtSize(val string) bool { return isTshirtSize(val) }
+
+// IsArbitraryValue returns true if the value is an arbitrary value like
+// [10px].
+func IsArbitraryValue(val string) bool { return isArbitraryValue(val) }
+
+// IsArbitraryLength returns true if the value is an arbitrary length like
+// [10px] or [length:var(--x)].
+func IsArbitraryLength(val string) bool { return isArbitraryLength(val) }
+
+// DefaultConfig returns a copy of the default [Config].
+func DefaultConfig() *Config { return defaultConfig().clone() }
+
+// ExtendConfig returns the default [Config] with the given extensions
+// applied.
+//
+// It is the equivalent of tailwind-merge's extendTailwindMerge.
+func ExtendConfig(exts ...ConfigExtension) *Config {
+	return MergeConfig(defaultConfig(), exts...)
+}
+
+// MergeConfig returns a copy of base with the given extensions applied in
+// order.
+//
+// Overrides are applied before extensions. The base config is not
+// modified.
+func MergeConfig(base *Config, exts ...ConfigExtension) *Config {
+	cfg := base.clone()
+	for _, ext := range exts {
+		for _, groupID := range slices.Sorted(maps.Keys(ext.Override.ClassGroups)) {
+			removeClassGroup(&cfg.ClassGroups, groupID)
+			cfg.addClassGroup(groupID, ext.Override.ClassGroups[groupID])
+		}
+		overrideConflicts(cfg.ConflictingClassGroups, ext.Override.ConflictingClassGroups)
+		overrideConflicts(cfg.ConflictingClassGroupModifiers, ext.Override.ConflictingClassGroupModifiers)
+		if ext.Override.Variants != nil {
+			cfg.Variants = slices.Clone(ext.Override.Variants)
+		}
+		if ext.Override.OrderSensitiveModifiers != nil {
+			cfg.OrderSensitiveModifiers = slices.Clone(ext.Override.OrderSensitiveModifiers)
+		}
+		overrideTheme(cfg.Theme, ext.Override.Theme)
+		overrideProperties(cfg.Properties, ext.Override.Properties)
+		for _, variant := range ext.Extend.Variants {
+			if !slices.Contains(cfg.Variants, variant) {
+				cfg.Variants = append(cfg.Variants, variant)
+			}
+		}
+		for _, modifier := range ext.Extend.OrderSensitiveModifiers {
+			if !slices.Contains(cfg.OrderSensitiveModifiers, modifier) {
+				cfg.OrderSensitiveModifiers = append(cfg.OrderSensitiveModifiers, modifier)
+			}
+		}
+		for _, groupID := range slices.Sorted(maps.Keys(ext.Extend.ClassGroups)) {
+			cfg.addClassGroup(groupID, ext.Extend.ClassGroups[groupID])
+		}
+		extendConflicts(cfg.ConflictingClassGroups, ext.Extend.ConflictingClassGroups)
+		extendConflicts(cfg.ConflictingClassGroupModifiers, ext.Extend.ConflictingClassGroupModifiers)
+		extendTheme(cfg.Theme, ext.Extend.Theme)
+		extendProperties(cfg.Properties, ext.Extend.Properties)
+	}
+	return cfg
+}
+
+// clone returns a deep copy of the config.
+func (c *Config) clone() *Config {
+	cfg := *c
+	cfg.ClassGroups = *cloneClassPart(&c.ClassGroups)
+	cfg.Variants = slices.Clone(c.Variants)
+	cfg.OrderSensitiveModifiers = slices.Clone(c.OrderSensitiveModifiers)
+	cfg.ConflictingClassGroups = cloneConflicts(c.ConflictingClassGroups)
+	cfg.ConflictingClassGroupModifiers = cloneConflicts(c.ConflictingClassGroupModifiers)
+	cfg.Theme = c.Theme.clone()
+	cfg.Properties = c.Properties.clone()
+	cfg.compiled = nil
+	return &cfg
+}
+
+// addClassGroup adds the class definitions of a class group to the trie.
+//
+// The definitions are added last to first, so that their validators keep
+// their order when prepended.
+func (c *Config) addClassGroup(groupID string, defs []ClassDefinition) {
+	for _, def := range slices.Backward(defs) {
+		var path []string
+		if def.Class != "" {
+			path = strings.Split(def.Class, string(c.ClassSeparator))
+		}
+		addClassDefinition(&c.ClassGroups, path, groupID, def)
+	}
+}
+
+func cloneConflicts(conflicts ConflictingClassGroups) ConflictingClassGroups {
+	clone := make(ConflictingClassGroups, len(conflicts))
+	for groupID, groups := range conflicts {
+		clone[groupID] = slices.Clone(groups)
+	}
+	return clone
+}
+
+// overrideConflicts replaces the conflicts of the class groups in src.
+func overrideConflicts(dst, src ConflictingClassGroups) {
+	for groupID, groups := range src {
+		dst[groupID] = slices.Clone(groups)
+	}
+}
+
+// extendConflicts adds the conflicts of the class groups in src that are
+// not yet in dst.
+func extendConflicts(dst, src ConflictingClassGroups) {
+	for groupID, groups := range src {
+		for _, group := range groups {
+			if !slices.Contains(dst[groupID], group) {
+				dst[groupID] = append(dst[groupID], group)
+			}
+		}
+	}
+}
+
+func cloneClassPart(part *ClassPart) *ClassPart {
+	clone := &ClassPart{
+		Validators:   slices.Clone(part.Validators),
+		ClassGroupID: part.ClassGroupID,
+	}
+	if part.NextPart != nil {
+		clone.NextPart = make(map[string]*ClassPart, len(part.NextPart))
+		for key, next := range part.NextPart {
+			clone.NextPart[key] = cloneClassPart(next)
+		}
+	}
+	return clone
+}
+
+func addClassDefinition(
+	part *ClassPart,
+	path []string,
+	groupID string,
+	def ClassDefinition,
+) {
+	if len(path) == 0 {
+		if def.Validator == nil && def.Theme == "" {
+			part.ClassGroupID = groupID
+			return
+		}
+		validator := ClassGroupValidator{Fn: def.Validator, ClassGroupID: groupID}
+		if def.Validator == nil {
+			validator.Theme = def.Theme
+		}
+		part.Validators = append([]ClassGroupValidator{validator}, part.Validators...)
+		return
+	}
+	if part.NextPart == nil {
+		part.NextPart = make(map[string]*ClassPart)
+	}
+	next, ok := part.NextPart[path[0]]
+	if !ok {
+		next = &ClassPart{}
+		part.NextPart[path[0]] = next
+	}
+	addClassDefinition(next, path[1:], groupID, def)
+}
+
+// removeClassGroup removes every class and validator of a class group from
+// the trie.
+func removeClassGroup(part *ClassPart, groupID string) {
+	if part.ClassGroupID == groupID {
+		part.ClassGroupID = ""
+	}
+	part.Validators = slices.DeleteFunc(
+		part.Validators,
+		func(v ClassGroupValidator) bool { return v.ClassGroupID == groupID },
+	)
+	for _, next := range part.NextPart {
+		removeClassGroup(next, groupID)
+	}
+}
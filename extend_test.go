@@ -0,0 +1,69 @@
+package twerge
+
+import "testing"
+
+func TestExtendConfig(t *testing.T) {
+	cfg := ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{
+			ClassGroups: map[string][]ClassDefinition{
+				"text-brand": {
+					{Class: "text-brand"},
+					{Class: "text-brand", Validator: IsAny},
+				},
+				"shadow-elevation": {
+					{Class: "shadow-elevation", Validator: IsInteger},
+				},
+			},
+			ConflictingClassGroups: ConflictingClassGroups{
+				"shadow-elevation": {"shadow"},
+			},
+		},
+	})
+	testMerges(t, New(NewHandler(cfg)), []mergeTest{
+		{
+			in:  "text-brand-500 text-brand-600",
+			out: "text-brand-600",
+		}, {
+			in:  "text-red-500 text-brand-600 text-lg",
+			out: "text-red-500 text-brand-600 text-lg",
+		}, {
+			in:  "shadow-lg shadow-elevation-2",
+			out: "shadow-elevation-2",
+		}, {
+			in:  "shadow-elevation-1 shadow-elevation-2",
+			out: "shadow-elevation-2",
+		},
+	})
+
+	// the default config must be left untouched
+	got := New(NewHandler(nil)).Merge("text-brand-500 text-brand-600")
+	if got != "text-brand-600" {
+		t.Errorf("default config: got %s", got)
+	}
+	if got := New(NewHandler(nil)).Merge("shadow-lg shadow-elevation-2"); got != "shadow-lg shadow-elevation-2" {
+		t.Errorf("default config should not know shadow-elevation: got %s", got)
+	}
+}
+
+func TestMergeConfigOverride(t *testing.T) {
+	cfg := MergeConfig(DefaultConfig(), ConfigExtension{
+		Override: ConfigGroups{
+			ClassGroups: map[string][]ClassDefinition{
+				"display": {{Class: "block"}, {Class: "flex"}},
+			},
+			ConflictingClassGroups: ConflictingClassGroups{
+				"p": {"px"},
+			},
+		},
+	})
+	h := New(NewHandler(cfg))
+	if got := h.Merge("inline block"); got != "inline block" {
+		t.Errorf("overridden display group: got %s", got)
+	}
+	if got := h.Merge("block flex"); got != "flex" {
+		t.Errorf("overridden display group: got %s", got)
+	}
+	if got := h.Merge("px-2 py-2 p-4"); got != "py-2 p-4" {
+		t.Errorf("overridden conflicts: got %s", got)
+	}
+}
@@ -0,0 +1,145 @@
+package twerge
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ConflictGraph is the graph of the conflicting class groups of a config,
+// meant to review what a config change does.
+//
+// It encodes to JSON with encoding/json, or to Graphviz with
+// [ConflictGraph.DOT].
+type ConflictGraph struct {
+	// Groups are the class group IDs of the config, sorted.
+	Groups []string `json:"groups"`
+	// Conflicts maps a class group ID to the class groups it overrides
+	// directly -> p: [px py ps pe pt pr pb pl].
+	Conflicts map[string][]string `json:"conflicts"`
+	// PostfixConflicts maps a class group ID to the class groups it also
+	// overrides with a postfix -> font-size: [leading] of text-lg/7.
+	PostfixConflicts map[string][]string `json:"postfixConflicts"`
+}
+
+// GroupOf returns the class group of a class, empty if it is not a Tailwind
+// class.
+//
+// Example: GroupOf("hover:inset-x-2") -> inset-x
+func GroupOf(class string) string {
+	return Default().GroupOf(class)
+}
+
+// ConflictsOf returns the class groups that a class of the class group
+// overrides, sorted.
+//
+// Example: ConflictsOf("inset") -> [bottom end inset-x inset-y left right
+// start top]
+func ConflictsOf(groupID string) []string {
+	return Default().ConflictsOf(groupID)
+}
+
+// Groups returns the class group IDs of the config, sorted.
+func Groups() []string {
+	return Default().Groups()
+}
+
+// Graph returns the graph of the conflicting class groups of the config.
+func Graph() ConflictGraph {
+	return Default().Graph()
+}
+
+// GroupOf returns the class group of a class, empty if it is not a Tailwind
+// class.
+func (g *Generator) GroupOf(class string) string {
+	return g.mergeHandler().GroupOf(class)
+}
+
+// ConflictsOf returns the class groups that a class of the class group
+// overrides, sorted.
+func (g *Generator) ConflictsOf(groupID string) []string {
+	return g.mergeHandler().ConflictsOf(groupID)
+}
+
+// Groups returns the class group IDs of the config, sorted.
+func (g *Generator) Groups() []string {
+	return g.mergeHandler().Groups()
+}
+
+// Graph returns the graph of the conflicting class groups of the config.
+func (g *Generator) Graph() ConflictGraph {
+	return g.mergeHandler().Graph()
+}
+
+// GroupOf returns the class group of a class, empty if it is not a Tailwind
+// class.
+func (g *defaultHandler) GroupOf(class string) string {
+	decision, err := g.parse(class, nil)
+	if err != nil {
+		return ""
+	}
+	return decision.GroupID
+}
+
+// ConflictsOf returns the class groups that a class of the class group
+// overrides, sorted.
+func (g *defaultHandler) ConflictsOf(groupID string) []string {
+	return slices.Sorted(slices.Values(g.config.ConflictingClassGroups[groupID]))
+}
+
+// Groups returns the class group IDs of the config, sorted.
+func (g *defaultHandler) Groups() []string {
+	return slices.Sorted(slices.Values(g.table().groupIDs))
+}
+
+// Graph returns the graph of the conflicting class groups of the config.
+func (g *defaultHandler) Graph() ConflictGraph {
+	return ConflictGraph{
+		Groups:           g.Groups(),
+		Conflicts:        sortedConflicts(g.config.ConflictingClassGroups),
+		PostfixConflicts: sortedConflicts(g.config.ConflictingClassGroupModifiers),
+	}
+}
+
+// sortedConflicts returns a copy of the conflicts with their class groups
+// sorted, leaving out the class groups without conflicts.
+func sortedConflicts(conflicts ConflictingClassGroups) map[string][]string {
+	sorted := make(map[string][]string, len(conflicts))
+	for groupID, groups := range conflicts {
+		if len(groups) > 0 {
+			sorted[groupID] = slices.Sorted(slices.Values(groups))
+		}
+	}
+	return sorted
+}
+
+// DOT returns the graph in the Graphviz DOT language, an edge goes from a
+// class group to a class group it overrides, dashed for postfix conflicts.
+//
+// Example: dot -Tsvg conflicts.dot -o conflicts.svg
+func (c ConflictGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph conflicts {\n")
+	for _, groupID := range c.Groups {
+		fmt.Fprintf(&b, "\t%s;\n", strconv.Quote(groupID))
+	}
+	for _, groupID := range slices.Sorted(maps.Keys(c.Conflicts)) {
+		for _, conflict := range c.Conflicts[groupID] {
+			fmt.Fprintf(&b, "\t%s -> %s;\n", strconv.Quote(groupID), strconv.Quote(conflict))
+		}
+	}
+	for _, groupID := range slices.Sorted(maps.Keys(c.PostfixConflicts)) {
+		for _, conflict := range c.PostfixConflicts[groupID] {
+			fmt.Fprintf(
+				&b,
+				"\t%s -> %s [style=dashed];\n",
+				strconv.Quote(groupID),
+				strconv.Quote(conflict),
+			)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
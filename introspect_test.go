@@ -0,0 +1,125 @@
+package twerge
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGroupOf(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"inset-x-2", "inset-x"},
+		{"hover:!-mt-4", "mt"},
+		{"text-lg/7", "font-size"},
+		{"[mask-type:luminance]", "arbitrary..mask-type"},
+		{"custom", ""},
+		{"hover:", ""},
+	}
+	g := New(newDefaultHandler())
+	for _, tt := range tests {
+		if got := g.GroupOf(tt.in); got != tt.want {
+			t.Errorf("GroupOf(%q) = %q, wanted %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestConflictsOf(t *testing.T) {
+	g := New(newDefaultHandler())
+	// inset-x and inset-y conflict with left, right, top and bottom
+	want := []string{"bottom", "end", "inset-x", "inset-y", "left", "right", "start", "top"}
+	if got := g.ConflictsOf("inset"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ConflictsOf(inset) = %v, wanted %v", got, want)
+	}
+	if got := g.ConflictsOf("left"); len(got) != 0 {
+		t.Errorf("ConflictsOf(left) = %v, wanted none", got)
+	}
+
+	cfg := MergeConfig(DefaultConfig(), ConfigExtension{
+		Extend: ConfigGroups{
+			ConflictingClassGroups: ConflictingClassGroups{"card-padding": {"p"}},
+		},
+	})
+	got := New(NewHandler(cfg)).ConflictsOf("card-padding")
+	if !reflect.DeepEqual(got, []string{"p"}) {
+		t.Errorf("ConflictsOf(card-padding) = %v, wanted [p]", got)
+	}
+}
+
+func TestConflictsOfMerge(t *testing.T) {
+	g := New(newDefaultHandler())
+	// a class of each conflict is erased by a later class of the group
+	tests := []struct{ groupID, class, later string }{
+		{"inset", "left-2", "inset-4"},
+		{"inset", "inset-x-2", "inset-4"},
+		{"p", "pl-2", "p-4"},
+		{"p", "px-2", "p-4"},
+	}
+	for _, tt := range tests {
+		if !slices.Contains(g.ConflictsOf(tt.groupID), g.GroupOf(tt.class)) {
+			t.Errorf("ConflictsOf(%s) misses %s", tt.groupID, g.GroupOf(tt.class))
+		}
+		if got := g.Merge(tt.class + " " + tt.later); got != tt.later {
+			t.Errorf("Merge(%q) = %q, wanted %q", tt.class+" "+tt.later, got, tt.later)
+		}
+	}
+	// p overrides pl, but a group overriding p does not
+	cfg := MergeConfig(DefaultConfig(), ConfigExtension{
+		Extend: ConfigGroups{
+			ClassGroups:            map[string][]ClassDefinition{"card-padding": {{Class: "card-p"}}},
+			ConflictingClassGroups: ConflictingClassGroups{"card-padding": {"p"}},
+		},
+	})
+	g = New(NewHandler(cfg))
+	if slices.Contains(g.ConflictsOf("card-padding"), "pl") {
+		t.Errorf("ConflictsOf(card-padding) = %v, wanted no pl", g.ConflictsOf("card-padding"))
+	}
+	if got := g.Merge("pl-2 card-p"); got != "pl-2 card-p" {
+		t.Errorf("Merge(pl-2 card-p) = %q, wanted pl-2 card-p", got)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	groups := New(newDefaultHandler()).Groups()
+	if !slices.IsSorted(groups) {
+		t.Errorf("Groups() is not sorted")
+	}
+	for _, groupID := range []string{"p", "inset-x", "font-size", "leading"} {
+		if !slices.Contains(groups, groupID) {
+			t.Errorf("Groups() does not contain %s", groupID)
+		}
+	}
+}
+
+func TestGraph(t *testing.T) {
+	graph := New(newDefaultHandler()).Graph()
+	if want := []string{"leading"}; !reflect.DeepEqual(graph.PostfixConflicts["font-size"], want) {
+		t.Errorf("PostfixConflicts[font-size] = %v, wanted %v", graph.PostfixConflicts["font-size"], want)
+	}
+
+	data, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded ConflictGraph
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded, graph) {
+		t.Errorf("the graph does not survive a JSON round trip")
+	}
+
+	dot := graph.DOT()
+	for _, want := range []string{
+		"digraph conflicts {",
+		"\t\"inset-x\" -> \"left\";",
+		"\t\"font-size\" -> \"leading\" [style=dashed];",
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT() does not contain %q", want)
+		}
+	}
+}
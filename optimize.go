@@ -0,0 +1,294 @@
+package twerge
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// optimization rewrites merged classes into equivalent ones.
+type optimization func(h *defaultHandler, classes string) string
+
+// WithShorthands makes the [Generator] collapse longhand classes with the
+// same value and modifiers into their shorthand -> pt-4 pb-4 pl-4 pr-4
+// becomes p-4.
+//
+// It applies to [Generator.Merge] and to the merged classes written by
+// [CodeGen].
+func WithShorthands() Option {
+	return func(g *Generator) {
+		g.optimizations = append(g.optimizations, (*defaultHandler).collapseShorthands)
+	}
+}
+
+// optimize applies the optimizations of the generator to merged classes,
+// and sorts them with [WithSortedClasses].
+func (g *Generator) optimize(classes string) string {
+	if len(g.optimizations) == 0 && !g.sorted {
+		return classes
+	}
+	h := g.mergeHandler()
+	for _, opt := range g.optimizations {
+		classes = opt(h, classes)
+	}
+	if g.sorted {
+		classes = h.Sort(classes)
+	}
+	return classes
+}
+
+// optimizeCache applies the optimizations of the generator to the merged
+// classes of the cache.
+func (g *Generator) optimizeCache() {
+	if len(g.optimizations) == 0 && !g.sorted {
+		return
+	}
+	cache := g.Cache()
+	for raw, value := range cache {
+		value.Merged = g.optimize(value.Merged)
+		cache[raw] = value
+	}
+	g.Handler.SetCache(cache)
+}
+
+// shorthandRule collapses the classes of two longhand class groups into a
+// class of the shorthand class group.
+type shorthandRule struct {
+	shorthand string
+	longhands [2]string
+}
+
+// shorthandRules are ordered so that the rules of the longhands of a
+// shorthand come first -> pl pr becomes px before px py becomes p.
+var shorthandRules = func() []shorthandRule {
+	var rules []shorthandRule
+	for _, prefix := range []string{"p", "m", "scroll-p", "scroll-m"} {
+		rules = append(rules,
+			shorthandRule{prefix + "x", [2]string{prefix + "l", prefix + "r"}},
+			shorthandRule{prefix + "y", [2]string{prefix + "t", prefix + "b"}},
+			shorthandRule{prefix, [2]string{prefix + "x", prefix + "y"}},
+		)
+	}
+	for _, prefix := range []string{"border-w", "border-color"} {
+		rules = append(rules,
+			shorthandRule{prefix + "-x", [2]string{prefix + "-l", prefix + "-r"}},
+			shorthandRule{prefix + "-y", [2]string{prefix + "-t", prefix + "-b"}},
+			shorthandRule{prefix, [2]string{prefix + "-x", prefix + "-y"}},
+		)
+	}
+	return append(rules,
+		shorthandRule{"rounded-t", [2]string{"rounded-tl", "rounded-tr"}},
+		shorthandRule{"rounded-b", [2]string{"rounded-bl", "rounded-br"}},
+		shorthandRule{"rounded-l", [2]string{"rounded-tl", "rounded-bl"}},
+		shorthandRule{"rounded-r", [2]string{"rounded-tr", "rounded-br"}},
+		shorthandRule{"rounded", [2]string{"rounded-t", "rounded-b"}},
+		shorthandRule{"rounded", [2]string{"rounded-l", "rounded-r"}},
+		shorthandRule{"inset-x", [2]string{"left", "right"}},
+		shorthandRule{"inset-y", [2]string{"top", "bottom"}},
+		shorthandRule{"inset", [2]string{"inset-x", "inset-y"}},
+		shorthandRule{"gap", [2]string{"gap-x", "gap-y"}},
+		shorthandRule{"overflow", [2]string{"overflow-x", "overflow-y"}},
+		shorthandRule{"overscroll", [2]string{"overscroll-x", "overscroll-y"}},
+	)
+}()
+
+// shorthandClasses are the classes of the class groups whose class is not
+// their ID, the others are named like their class group -> pt-4 of pt.
+var shorthandClasses = map[string]string{
+	"border-w":       "border",
+	"border-w-x":     "border-x",
+	"border-w-y":     "border-y",
+	"border-w-t":     "border-t",
+	"border-w-r":     "border-r",
+	"border-w-b":     "border-b",
+	"border-w-l":     "border-l",
+	"border-color":   "border",
+	"border-color-x": "border-x",
+	"border-color-y": "border-y",
+	"border-color-t": "border-t",
+	"border-color-r": "border-r",
+	"border-color-b": "border-b",
+	"border-color-l": "border-l",
+}
+
+// shorthandItem is a merged class split around its value.
+type shorthandItem struct {
+	class string
+	group string
+	scope scopeKey
+	// lead holds the modifiers and important modifier before the value,
+	// trail the important modifier after it -> hover:! and "" of
+	// hover:!-mt-4
+	lead, trail string
+	// prefix is the v3 prefix of the class -> tw- of -tw-mt-4
+	prefix   string
+	negative bool
+	value    string
+	// collapsible is false for classes that are not Tailwind classes, that
+	// have a postfix or whose class is not followed by their value
+	collapsible bool
+}
+
+// collapseShorthands collapses longhand classes with the same value and
+// modifiers into their shorthand.
+//
+// Longhands are only collapsed when no other class with the same
+// modifiers sets a part of the shorthand -> px-2 pl-4 pr-4 is left as
+// is, as px-4 would override px-2.
+func (g *defaultHandler) collapseShorthands(classes string) string {
+	decisions, _ := g.parseAll(classes)
+	items := make([]shorthandItem, 0, len(decisions))
+	for _, decision := range decisions {
+		items = append(items, g.shorthandItem(decision))
+	}
+
+	table := g.table()
+	for changed := true; changed; {
+		changed = false
+		for _, rule := range shorthandRules {
+			for i := 0; i < len(items); i++ {
+				j := matchLonghand(items, i, rule)
+				if j == -1 || !g.canCollapse(table, items, rule.shorthand, i, j) {
+					continue
+				}
+				item, ok := g.collapse(items[i], rule.shorthand)
+				if !ok {
+					continue
+				}
+				items[i] = item
+				items = append(items[:j], items[j+1:]...)
+				changed = true
+			}
+		}
+	}
+
+	if len(items) == len(decisions) {
+		return classes
+	}
+	collapsed := make([]string, 0, len(items))
+	for _, item := range items {
+		collapsed = append(collapsed, item.class)
+	}
+	return strings.Join(collapsed, " ")
+}
+
+// shorthandItem splits a merged class around its value.
+func (g *defaultHandler) shorthandItem(decision Decision) shorthandItem {
+	item := shorthandItem{class: decision.Class, group: decision.GroupID}
+	if !decision.IsTailwind {
+		return item
+	}
+	item.scope = scopeKey{
+		modifiers: g.config.modifierID(decision.Class, decision.Modifiers),
+		important: decision.Important,
+	}
+	if decision.Postfix != "" {
+		return item
+	}
+
+	var base string
+	item.lead, base, item.trail = g.splitUtility(decision)
+	if !g.config.isVariantPrefix() {
+		// the prefix comes after the negative sign -> -tw-mt-4
+		base, _ = g.config.trimClassPrefix(base)
+		item.prefix = g.config.Prefix
+	}
+	base, item.negative = strings.CutPrefix(base, string(g.config.ClassSeparator))
+
+	prefix := groupClass(decision.GroupID)
+	if base == prefix {
+		item.collapsible = true
+	} else if value, ok := strings.CutPrefix(base, prefix+"-"); ok {
+		item.value = value
+		item.collapsible = true
+	}
+	return item
+}
+
+// splitUtility splits a class into the utility without its modifiers and
+// important modifier, and what comes before and after it -> hover:!,
+// -mt-4 and "" of hover:!-mt-4.
+func (g *defaultHandler) splitUtility(decision Decision) (lead, utility, trail string) {
+	utility, _ = g.config.trimVariantPrefix(decision.Class)
+	separator := utf8.RuneLen(g.config.ModifierSeparator)
+	for _, modifier := range decision.Modifiers {
+		utility = utility[len(modifier)+separator:]
+	}
+	important := string(g.config.ImportantModifier)
+	if rest, ok := strings.CutPrefix(utility, important); ok {
+		utility = rest
+	} else if rest, ok := strings.CutSuffix(utility, important); ok {
+		utility = rest
+		trail = important
+	}
+	lead = decision.Class[:len(decision.Class)-len(utility)-len(trail)]
+	return lead, utility, trail
+}
+
+// groupClass returns the class of a class group that its values follow.
+func groupClass(groupID string) string {
+	if class, ok := shorthandClasses[groupID]; ok {
+		return class
+	}
+	return groupID
+}
+
+// matchLonghand returns the index of the other longhand of the rule that
+// has the same value and modifiers as the longhand at i, or -1.
+func matchLonghand(items []shorthandItem, i int, rule shorthandRule) int {
+	first := items[i]
+	if !first.collapsible || first.group != rule.longhands[0] {
+		return -1
+	}
+	for j, item := range items {
+		if item.collapsible && item.group == rule.longhands[1] &&
+			item.scope == first.scope && item.trail == first.trail &&
+			item.negative == first.negative && item.value == first.value {
+			return j
+		}
+	}
+	return -1
+}
+
+// canCollapse returns true if no class other than the longhands at i and
+// j sets a part of the shorthand with the same modifiers.
+func (g *defaultHandler) canCollapse(
+	table *classTable,
+	items []shorthandItem,
+	shorthand string,
+	i, j int,
+) bool {
+	group, ok := table.groups[shorthand]
+	if !ok {
+		return false
+	}
+	for k, item := range items {
+		if k == i || k == j || item.group == "" || item.scope != items[i].scope {
+			continue
+		}
+		other, ok := table.groups[item.group]
+		if ok && table.claims(group, false, other, nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// collapse returns the longhand as a class of the shorthand, if it is a
+// valid class of the shorthand class group.
+func (g *defaultHandler) collapse(longhand shorthandItem, shorthand string) (shorthandItem, bool) {
+	base := groupClass(shorthand)
+	if longhand.value != "" {
+		base += string(g.config.ClassSeparator) + longhand.value
+	}
+	sign := ""
+	if longhand.negative {
+		sign = string(g.config.ClassSeparator)
+	}
+	if isTw, groupID := g.getClassGroupID(sign + base); !isTw || groupID != shorthand {
+		return longhand, false
+	}
+	item := longhand
+	item.group = shorthand
+	item.class = longhand.lead + sign + longhand.prefix + base + longhand.trail
+	return item, true
+}
@@ -0,0 +1,81 @@
+package twerge
+
+import "testing"
+
+func TestWithShorthands(t *testing.T) {
+	g := New(NewHandler(nil), WithShorthands())
+	testMerges(t, g, []mergeTest{
+		{
+			in:  "pt-4 pb-4 pl-4 pr-4",
+			out: "p-4",
+		}, {
+			in:  "px-2 py-2",
+			out: "p-2",
+		}, {
+			in:  "rounded-tl-md rounded-tr-md rounded-bl-md rounded-br-md",
+			out: "rounded-md",
+		}, {
+			in:  "font-bold pl-4 pr-4 pt-2",
+			out: "font-bold px-4 pt-2",
+		}, {
+			in:  "hover:mt-2 hover:mb-2 mt-2",
+			out: "hover:my-2 mt-2",
+		}, {
+			in:  "-mt-4 -mb-4 -ml-4 -mr-4",
+			out: "-m-4",
+		}, {
+			in:  "!pl-4 !pr-4 pl-2 pr-2",
+			out: "!px-4 px-2",
+		}, {
+			in:  "border-t-2 border-b-2 border-l-red-500 border-r-red-500",
+			out: "border-y-2 border-x-red-500",
+		}, {
+			in:  "border-t border-b border-l border-r",
+			out: "border",
+		}, {
+			in:  "gap-x-2 gap-y-2 overflow-x-auto overflow-y-auto",
+			out: "gap-2 overflow-auto",
+		}, {
+			// px-4 would override px-2
+			in:  "px-2 pl-4 pr-4",
+			out: "px-2 pl-4 pr-4",
+		}, {
+			in:  "pl-4 pr-2 hover:pr-4",
+			out: "pl-4 pr-2 hover:pr-4",
+		},
+	})
+
+	if got := New(NewHandler(nil)).Merge("px-2 py-2"); got != "px-2 py-2" {
+		t.Errorf("shorthands should be opt-in: %s", got)
+	}
+
+	// CodeGen optimizes the merged classes of the cache
+	g.It("pl-2 pl-4 pr-4")
+	g.optimizeCache()
+	if got := g.Handler.Cache()["pl-2 pl-4 pr-4"].Merged; got != "px-4" {
+		t.Errorf("cached Merged = %s, want px-4", got)
+	}
+}
+
+func TestWithShorthandsPrefix(t *testing.T) {
+	tt := []struct {
+		prefix string
+		in     string
+		out    string
+	}{
+		{"tw-", "tw-pl-4 tw-pr-4", "tw-px-4"},
+		{"tw-", "hover:-tw-mt-2 hover:-tw-mb-2 !tw-gap-x-1 !tw-gap-y-1", "hover:-tw-my-2 !tw-gap-1"},
+		{"tw-", "pl-4 pr-4", "pl-4 pr-4"},
+		{"tw:", "tw:pl-4 tw:pr-4 tw:-ml-2! tw:-mr-2!", "tw:px-4 tw:-mx-2!"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.prefix+" "+tc.in, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Prefix = tc.prefix
+			got := New(NewHandler(cfg), WithShorthands()).Merge(tc.in)
+			if got != tc.out {
+				t.Errorf("Merge(%q) = %q, wanted %q", tc.in, got, tc.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package twerge
+
+import "slices"
+
+// TypographyPlugin returns the class groups of the @tailwindcss/typography
+// plugin.
+//
+// The element modifiers like prose-headings are known variants, so that
+// prose-headings:underline only conflicts with the other classes of the
+// headings.
+//
+// Example: ExtendConfig(TypographyPlugin())
+func TypographyPlugin() ConfigExtension {
+	elements := slices.Clone(proseElements)
+	return ConfigExtension{Extend: ConfigGroups{
+		ClassGroups: map[string][]ClassDefinition{
+			"prose":        {{Class: "prose"}},
+			"not-prose":    {{Class: "not-prose"}},
+			"prose-size":   literals("prose", "sm", "base", "lg", "xl", "2xl"),
+			"prose-color":  literals("prose", "gray", "slate", "zinc", "neutral", "stone"),
+			"prose-invert": {{Class: "prose-invert"}},
+		},
+		Variants: elements,
+		// prose-a:hover:underline styles hovered links while
+		// hover:prose-a:underline styles the links of a hovered element
+		OrderSensitiveModifiers: elements,
+	}}
+}
+
+// proseElements are the element modifiers of the typography plugin.
+var proseElements = []string{
+	"prose-headings", "prose-lead", "prose-h1", "prose-h2", "prose-h3",
+	"prose-h4", "prose-h5", "prose-h6", "prose-p", "prose-a",
+	"prose-blockquote", "prose-figure", "prose-figcaption", "prose-strong",
+	"prose-em", "prose-kbd", "prose-code", "prose-pre", "prose-ol",
+	"prose-ul", "prose-li", "prose-dl", "prose-dt", "prose-dd",
+	"prose-table", "prose-thead", "prose-tr", "prose-th", "prose-td",
+	"prose-img", "prose-picture", "prose-video", "prose-hr",
+}
+
+// FormsPlugin returns the class groups of the @tailwindcss/forms plugin
+// with the class strategy, an element is styled as one form control.
+//
+// Example: ExtendConfig(FormsPlugin())
+func FormsPlugin() ConfigExtension {
+	return ConfigExtension{Extend: ConfigGroups{
+		ClassGroups: map[string][]ClassDefinition{
+			"form-control": literals(
+				"form",
+				"input", "textarea", "select", "multiselect", "checkbox",
+				"radio",
+			),
+		},
+	}}
+}
+
+// ContainerQueriesPlugin returns the class groups of the
+// @tailwindcss/container-queries plugin for Tailwind CSS v3, container
+// queries are built into Tailwind CSS v4.
+//
+// Named containers like @container/sidebar are the container class with a
+// postfix.
+//
+// Example: ExtendConfig(ContainerQueriesPlugin())
+func ContainerQueriesPlugin() ConfigExtension {
+	return ConfigExtension{Extend: ConfigGroups{
+		ClassGroups: map[string][]ClassDefinition{
+			"container-type": {
+				{Class: "@container"},
+				{Class: "@container-normal"},
+			},
+		},
+		Properties: ClassGroupProperties{
+			"container-type": {"container-type"},
+		},
+	}}
+}
+
+// AnimatePlugin returns the class groups of the tailwindcss-animate plugin.
+//
+// animate-in and animate-out set the animation, so they join the animate
+// class group and override animate-spin.
+//
+// Example: ExtendConfig(AnimatePlugin())
+func AnimatePlugin() ConfigExtension {
+	return ConfigExtension{Extend: ConfigGroups{
+		ClassGroups: map[string][]ClassDefinition{
+			"animate":  literals("animate", "in", "out"),
+			"fade-in":  animateValues("fade-in", isNumber),
+			"fade-out": animateValues("fade-out", isNumber),
+			"zoom-in":  animateValues("zoom-in", isNumber),
+			"zoom-out": animateValues("zoom-out", isNumber),
+			"spin-in":  animateValues("spin-in", isNumber),
+			"spin-out": animateValues("spin-out", isNumber),
+			// the vertical and horizontal slides set different
+			// translations -> slide-in-from-top-2 slide-in-from-left-2
+			"slide-in-from-y": slices.Concat(
+				animateValues("slide-in-from-top", isLength),
+				animateValues("slide-in-from-bottom", isLength),
+			),
+			"slide-in-from-x": slices.Concat(
+				animateValues("slide-in-from-left", isLength),
+				animateValues("slide-in-from-right", isLength),
+			),
+			"slide-out-to-y": slices.Concat(
+				animateValues("slide-out-to-top", isLength),
+				animateValues("slide-out-to-bottom", isLength),
+			),
+			"slide-out-to-x": slices.Concat(
+				animateValues("slide-out-to-left", isLength),
+				animateValues("slide-out-to-right", isLength),
+			),
+			"fill-mode": literals("fill-mode", "none", "forwards", "backwards", "both"),
+			"animation-direction": literals(
+				"direction",
+				"normal", "reverse", "alternate", "alternate-reverse",
+			),
+			"animation-play-state": {{Class: "running"}, {Class: "paused"}},
+			"repeat": {
+				{Class: "repeat", Validator: isInteger},
+				{Class: "repeat-infinite"},
+				{Class: "repeat", Validator: isArbitraryValue},
+			},
+		},
+		Properties: ClassGroupProperties{
+			"fill-mode":            {"animation-fill-mode"},
+			"animation-direction":  {"animation-direction"},
+			"animation-play-state": {"animation-play-state"},
+			"repeat":               {"animation-iteration-count"},
+		},
+	}}
+}
+
+// animateValues returns the definitions of an animation class that is used
+// on its own or with a value -> fade-in, fade-in-25 and fade-in-[.3].
+func animateValues(class string, validator func(string) bool) []ClassDefinition {
+	return []ClassDefinition{
+		{Class: class},
+		{Class: class, Validator: validator},
+		{Class: class, Validator: isArbitraryValue},
+	}
+}
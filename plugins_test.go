@@ -0,0 +1,72 @@
+package twerge
+
+import "testing"
+
+func TestTypographyPlugin(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(TypographyPlugin())))
+	testGroupOf(t, g, map[string]string{
+		"prose":        "prose",
+		"prose-lg":     "prose-size",
+		"prose-slate":  "prose-color",
+		"prose-invert": "prose-invert",
+		"not-prose":    "not-prose",
+		// element modifiers are variants of the utility
+		"prose-headings:underline": "text-decoration",
+	})
+	if issues := g.Validate("prose-a:hover:underline prose-img:rounded"); len(issues) != 0 {
+		t.Errorf("Validate() = %v, wanted the element modifiers to be known variants", issues)
+	}
+	// the element modifiers are order sensitive
+	testMerges(t, g, []mergeTest{
+		{in: "prose-a:underline prose-headings:no-underline", out: "prose-a:underline prose-headings:no-underline"},
+		{in: "prose-a:hover:text-red-500 hover:prose-a:text-blue-500", out: "prose-a:hover:text-red-500 hover:prose-a:text-blue-500"},
+	})
+	if got := GroupOf("prose-lg"); got != "" {
+		t.Errorf("GroupOf(prose-lg) = %q without the plugin, wanted none", got)
+	}
+}
+
+func TestFormsPlugin(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(FormsPlugin())))
+	testGroupOf(t, g, map[string]string{
+		"form-input":       "form-control",
+		"form-multiselect": "form-control",
+		"form-range":       "",
+	})
+}
+
+func TestContainerQueriesPlugin(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(ContainerQueriesPlugin())))
+	testGroupOf(t, g, map[string]string{
+		"@container":         "container-type",
+		"@container/sidebar": "container-type",
+		"@container-normal":  "container-type",
+	})
+	if got := g.Properties("@container"); len(got) != 1 || got[0] != "container-type" {
+		t.Errorf("Properties(@container) = %v, wanted [container-type]", got)
+	}
+}
+
+func TestAnimatePlugin(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(AnimatePlugin())))
+	testGroupOf(t, g, map[string]string{
+		// animate-in sets the animation like animate-spin
+		"animate-in":             "animate",
+		"fade-in":                "fade-in",
+		"fade-in-25":             "fade-in",
+		"zoom-in-[.3]":           "zoom-in",
+		"slide-in-from-top-2":    "slide-in-from-y",
+		"slide-in-from-left-1/2": "slide-in-from-x",
+		"slide-out-to-right":     "slide-out-to-x",
+		"fill-mode-both":         "fill-mode",
+		"fill-red-500":           "fill",
+		"direction-reverse":      "animation-direction",
+		"paused":                 "animation-play-state",
+		"repeat-infinite":        "repeat",
+		"repeat-[3]":             "repeat",
+		"fade-in-x":              "",
+	})
+	if got := g.Properties("repeat-2"); len(got) != 1 || got[0] != "animation-iteration-count" {
+		t.Errorf("Properties(repeat-2) = %v, wanted [animation-iteration-count]", got)
+	}
+}
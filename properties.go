@@ -0,0 +1,391 @@
+package twerge
+
+import (
+	"slices"
+	"strings"
+)
+
+// ClassGroupProperties maps a class group ID to the css properties set by
+// the classes of the group.
+//
+// Arbitrary properties conflict with the class groups through them, so
+// that [display:flex] overrides block and p-4 overrides [padding:4px].
+//
+// Example: ClassGroupProperties{"px": {"padding-left", "padding-right"}}
+type ClassGroupProperties map[string][]string
+
+// clone returns a deep copy of the properties.
+func (p ClassGroupProperties) clone() ClassGroupProperties {
+	clone := make(ClassGroupProperties, len(p))
+	for groupID, properties := range p {
+		clone[groupID] = slices.Clone(properties)
+	}
+	return clone
+}
+
+// overrideProperties replaces the properties of the class groups in src.
+func overrideProperties(dst, src ClassGroupProperties) {
+	for groupID, properties := range src {
+		dst[groupID] = slices.Clone(properties)
+	}
+}
+
+// extendProperties adds the properties of the class groups in src that
+// are not yet in dst.
+func extendProperties(dst, src ClassGroupProperties) {
+	for groupID, properties := range src {
+		for _, property := range properties {
+			if !slices.Contains(dst[groupID], property) {
+				dst[groupID] = append(dst[groupID], property)
+			}
+		}
+	}
+}
+
+// Properties returns the css properties set by a class, nil if the class
+// is not a Tailwind class or its class group has no known properties.
+//
+// Example: Properties("hover:px-4") -> [padding-left padding-right]
+func Properties(class string) []string {
+	return Default().Properties(class)
+}
+
+// Properties returns the css properties set by a class.
+func (g *Generator) Properties(class string) []string {
+	return g.mergeHandler().Properties(class)
+}
+
+// Properties returns the css properties set by a class.
+func (g *defaultHandler) Properties(class string) []string {
+	decision, err := g.parse(class, nil)
+	if err != nil || !decision.IsTailwind {
+		return nil
+	}
+	if property, ok := arbitraryProperty(decision.GroupID); ok {
+		return []string{property}
+	}
+	return slices.Clone(g.config.Properties[decision.GroupID])
+}
+
+// arbitraryProperty returns the css property of the class group of an
+// arbitrary property -> display of arbitrary..display.
+func arbitraryProperty(groupID string) (string, bool) {
+	property, ok := strings.CutPrefix(groupID, arbitraryGroupPrefix)
+	return property, ok && property != ""
+}
+
+// arbitraryGroupPrefix is the prefix of the class groups of arbitrary
+// properties, with two dots because one dot is used as prefix for class
+// groups in plugins.
+const arbitraryGroupPrefix = "arbitrary.."
+
+// overrides returns true if setting the css property also sets other,
+// because it is other or one of its shorthands -> padding overrides
+// padding-left.
+func overrides(property, other string) bool {
+	if property == other {
+		return true
+	}
+	for _, longhand := range shorthands[property] {
+		if overrides(longhand, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// setsProperty returns true if one of the properties overrides other.
+func setsProperty(properties []string, other string) bool {
+	for _, property := range properties {
+		if overrides(property, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// shorthands maps a css shorthand property to the properties it sets,
+// which might be shorthands themselves.
+var shorthands = map[string][]string{
+	"padding":             box("padding", ""),
+	"padding-inline":      {"padding-inline-start", "padding-inline-end"},
+	"padding-block":       {"padding-block-start", "padding-block-end"},
+	"margin":              box("margin", ""),
+	"margin-inline":       {"margin-inline-start", "margin-inline-end"},
+	"margin-block":        {"margin-block-start", "margin-block-end"},
+	"scroll-padding":      box("scroll-padding", ""),
+	"scroll-margin":       box("scroll-margin", ""),
+	"inset":               {"top", "right", "bottom", "left", "inset-inline", "inset-block"},
+	"inset-inline":        {"inset-inline-start", "inset-inline-end"},
+	"inset-block":         {"inset-block-start", "inset-block-end"},
+	"border":              {"border-width", "border-style", "border-color"},
+	"border-width":        box("border", "-width"),
+	"border-style":        box("border", "-style"),
+	"border-color":        box("border", "-color"),
+	"border-radius":       {"border-top-left-radius", "border-top-right-radius", "border-bottom-right-radius", "border-bottom-left-radius", "border-start-start-radius", "border-start-end-radius", "border-end-end-radius", "border-end-start-radius"},
+	"gap":                 {"row-gap", "column-gap"},
+	"overflow":            {"overflow-x", "overflow-y"},
+	"overscroll-behavior": {"overscroll-behavior-x", "overscroll-behavior-y"},
+	"flex":                {"flex-grow", "flex-shrink", "flex-basis"},
+	"flex-flow":           {"flex-direction", "flex-wrap"},
+	"grid-column":         {"grid-column-start", "grid-column-end"},
+	"grid-row":            {"grid-row-start", "grid-row-end"},
+	"grid-template":       {"grid-template-columns", "grid-template-rows"},
+	"place-content":       {"align-content", "justify-content"},
+	"place-items":         {"align-items", "justify-items"},
+	"place-self":          {"align-self", "justify-self"},
+	"background":          {"background-color", "background-image", "background-size", "background-position", "background-repeat", "background-attachment", "background-clip", "background-origin"},
+	"font":                {"font-family", "font-size", "font-weight", "font-style", "line-height"},
+	"text-decoration":     {"text-decoration-line", "text-decoration-style", "text-decoration-color", "text-decoration-thickness"},
+	"outline":             {"outline-width", "outline-style", "outline-color"},
+	"transition":          {"transition-property", "transition-duration", "transition-timing-function", "transition-delay"},
+	"list-style":          {"list-style-type", "list-style-position", "list-style-image"},
+}
+
+// box returns the sides of a box property -> padding-top of padding.
+func box(property, suffix string) []string {
+	sides := make([]string, 0, 8)
+	for _, side := range []string{
+		"top", "right", "bottom", "left", "inline", "block",
+		"inline-start", "inline-end", "block-start", "block-end",
+	} {
+		if suffix != "" && (side == "inline" || side == "block") {
+			continue
+		}
+		sides = append(sides, property+"-"+side+suffix)
+	}
+	return sides
+}
+
+// defaultProperties returns the css properties of the default class groups.
+//
+// Class groups that set their properties through css variables, like the
+// gradient stops, and the ones whose classes set different properties,
+// like break, are left out.
+func defaultProperties() ClassGroupProperties {
+	properties := ClassGroupProperties{
+		// Layout
+		"aspect":          {"aspect-ratio"},
+		"columns":         {"columns"},
+		"break-after":     {"break-after"},
+		"break-before":    {"break-before"},
+		"break-inside":    {"break-inside"},
+		"box-decoration":  {"box-decoration-break"},
+		"box":             {"box-sizing"},
+		"display":         {"display"},
+		"float":           {"float"},
+		"clear":           {"clear"},
+		"isolation":       {"isolation"},
+		"object-fit":      {"object-fit"},
+		"object-position": {"object-position"},
+		"overflow":        {"overflow"},
+		"overflow-x":      {"overflow-x"},
+		"overflow-y":      {"overflow-y"},
+		"overscroll":      {"overscroll-behavior"},
+		"overscroll-x":    {"overscroll-behavior-x"},
+		"overscroll-y":    {"overscroll-behavior-y"},
+		"position":        {"position"},
+		"inset":           {"inset"},
+		"inset-x":         {"left", "right"},
+		"inset-y":         {"top", "bottom"},
+		"start":           {"inset-inline-start"},
+		"end":             {"inset-inline-end"},
+		"top":             {"top"},
+		"right":           {"right"},
+		"bottom":          {"bottom"},
+		"left":            {"left"},
+		"visibility":      {"visibility"},
+		"z":               {"z-index"},
+		// Flexbox and Grid
+		"basis":           {"flex-basis"},
+		"flex-direction":  {"flex-direction"},
+		"flex-wrap":       {"flex-wrap"},
+		"flex":            {"flex"},
+		"grow":            {"flex-grow"},
+		"shrink":          {"flex-shrink"},
+		"order":           {"order"},
+		"grid-cols":       {"grid-template-columns"},
+		"col-start-end":   {"grid-column"},
+		"col-start":       {"grid-column-start"},
+		"col-end":         {"grid-column-end"},
+		"grid-rows":       {"grid-template-rows"},
+		"row-start-end":   {"grid-row"},
+		"row-start":       {"grid-row-start"},
+		"row-end":         {"grid-row-end"},
+		"grid-flow":       {"grid-auto-flow"},
+		"auto-cols":       {"grid-auto-columns"},
+		"auto-rows":       {"grid-auto-rows"},
+		"gap":             {"gap"},
+		"gap-x":           {"column-gap"},
+		"gap-y":           {"row-gap"},
+		"justify-content": {"justify-content"},
+		"justify-items":   {"justify-items"},
+		"justify-self":    {"justify-self"},
+		"align-content":   {"align-content"},
+		"align-items":     {"align-items"},
+		"align-self":      {"align-self"},
+		"place-content":   {"place-content"},
+		"place-items":     {"place-items"},
+		"place-self":      {"place-self"},
+		// Sizing
+		"w":     {"width"},
+		"min-w": {"min-width"},
+		"max-w": {"max-width"},
+		"h":     {"height"},
+		"min-h": {"min-height"},
+		"max-h": {"max-height"},
+		"size":  {"width", "height"},
+		// Typography
+		"font-size":                 {"font-size"},
+		"font-smoothing":            {"-webkit-font-smoothing", "-moz-osx-font-smoothing"},
+		"font-style":                {"font-style"},
+		"font-weight":               {"font-weight"},
+		"font-family":               {"font-family"},
+		"tracking":                  {"letter-spacing"},
+		"line-clamp":                {"overflow", "display", "-webkit-box-orient", "-webkit-line-clamp"},
+		"leading":                   {"line-height"},
+		"list-image":                {"list-style-image"},
+		"list-style-position":       {"list-style-position"},
+		"list-style-type":           {"list-style-type"},
+		"text-alignment":            {"text-align"},
+		"text-color":                {"color"},
+		"text-decoration":           {"text-decoration-line"},
+		"text-decoration-style":     {"text-decoration-style"},
+		"text-decoration-thickness": {"text-decoration-thickness"},
+		"underline-offset":          {"text-underline-offset"},
+		"text-decoration-color":     {"text-decoration-color"},
+		"text-transform":            {"text-transform"},
+		"text-overflow":             {"text-overflow"},
+		"text-wrap":                 {"text-wrap"},
+		"indent":                    {"text-indent"},
+		"vertical-align":            {"vertical-align"},
+		"whitespace":                {"white-space"},
+		"hyphens":                   {"hyphens"},
+		"content":                   {"content"},
+		// Backgrounds
+		"bg-attachment": {"background-attachment"},
+		"bg-clip":       {"background-clip"},
+		"bg-origin":     {"background-origin"},
+		"bg-position":   {"background-position"},
+		"bg-repeat":     {"background-repeat"},
+		"bg-size":       {"background-size"},
+		"bg-image":      {"background-image"},
+		"bg-color":      {"background-color"},
+		"bg-blend":      {"background-blend-mode"},
+		// Borders
+		"rounded":        {"border-radius"},
+		"rounded-s":      {"border-start-start-radius", "border-end-start-radius"},
+		"rounded-e":      {"border-start-end-radius", "border-end-end-radius"},
+		"rounded-t":      {"border-top-left-radius", "border-top-right-radius"},
+		"rounded-r":      {"border-top-right-radius", "border-bottom-right-radius"},
+		"rounded-b":      {"border-bottom-right-radius", "border-bottom-left-radius"},
+		"rounded-l":      {"border-top-left-radius", "border-bottom-left-radius"},
+		"rounded-ss":     {"border-start-start-radius"},
+		"rounded-se":     {"border-start-end-radius"},
+		"rounded-ee":     {"border-end-end-radius"},
+		"rounded-es":     {"border-end-start-radius"},
+		"rounded-tl":     {"border-top-left-radius"},
+		"rounded-tr":     {"border-top-right-radius"},
+		"rounded-br":     {"border-bottom-right-radius"},
+		"rounded-bl":     {"border-bottom-left-radius"},
+		"border-style":   {"border-style"},
+		"outline-style":  {"outline-style"},
+		"outline-offset": {"outline-offset"},
+		"outline-w":      {"outline-width"},
+		"outline-color":  {"outline-color"},
+		// Effects
+		"shadow":          {"box-shadow"},
+		"opacity":         {"opacity"},
+		"mix-blend":       {"mix-blend-mode"},
+		"border-collapse": {"border-collapse"},
+		"border-spacing":  {"border-spacing"},
+		"table-layout":    {"table-layout"},
+		"caption":         {"caption-side"},
+		// Transitions and Animation
+		"transition": {"transition-property", "transition-timing-function", "transition-duration"},
+		"duration":   {"transition-duration"},
+		"ease":       {"transition-timing-function"},
+		"delay":      {"transition-delay"},
+		"animate":    {"animation"},
+		// Transforms
+		"transform-origin": {"transform-origin"},
+		// Interactivity
+		"accent":          {"accent-color"},
+		"appearance":      {"appearance"},
+		"cursor":          {"cursor"},
+		"caret-color":     {"caret-color"},
+		"pointer-events":  {"pointer-events"},
+		"resize":          {"resize"},
+		"scroll-behavior": {"scroll-behavior"},
+		"snap-align":      {"scroll-snap-align"},
+		"snap-stop":       {"scroll-snap-stop"},
+		"snap-type":       {"scroll-snap-type"},
+		"touch":           {"touch-action"},
+		"select":          {"user-select"},
+		"will-change":     {"will-change"},
+		// SVG
+		"fill":     {"fill"},
+		"stroke":   {"stroke"},
+		"stroke-w": {"stroke-width"},
+		// Accessibility
+		"forced-color-adjust": {"forced-color-adjust"},
+	}
+	// the filters and transforms are combined through css variables, so
+	// their classes set the whole property
+	for _, groupID := range []string{
+		"filter", "blur", "brightness", "contrast", "drop-shadow",
+		"grayscale", "hue-rotate", "invert", "saturate", "sepia",
+	} {
+		properties[groupID] = []string{"filter"}
+		properties["backdrop-"+groupID] = []string{"backdrop-filter"}
+	}
+	delete(properties, "backdrop-drop-shadow")
+	properties["backdrop-opacity"] = []string{"backdrop-filter"}
+	for _, groupID := range []string{
+		"transform", "scale", "scale-x", "scale-y", "rotate",
+		"translate-x", "translate-y", "skew-x", "skew-y",
+	} {
+		properties[groupID] = []string{"transform"}
+	}
+	for prefix, property := range map[string]string{
+		"p":        "padding",
+		"m":        "margin",
+		"scroll-p": "scroll-padding",
+		"scroll-m": "scroll-margin",
+	} {
+		addSides(properties, prefix, property, "")
+	}
+	addSides(properties, "border-w", "border", "-width")
+	addSides(properties, "border-color", "border", "-color")
+	return properties
+}
+
+// addSides adds the properties of the class groups of a box property, the
+// groups are named like p, px and pt or border-w, border-w-x and
+// border-w-t.
+func addSides(properties ClassGroupProperties, prefix, property, suffix string) {
+	separator := ""
+	if suffix != "" {
+		separator = "-"
+		properties[prefix] = []string{property + suffix}
+	} else {
+		properties[prefix] = []string{property}
+	}
+	for side, sides := range map[string][]string{
+		"x": {"left", "right"},
+		"y": {"top", "bottom"},
+		"s": {"inline-start"},
+		"e": {"inline-end"},
+		"t": {"top"},
+		"r": {"right"},
+		"b": {"bottom"},
+		"l": {"left"},
+	} {
+		for _, s := range sides {
+			properties[prefix+separator+side] = append(
+				properties[prefix+separator+side],
+				property+"-"+s+suffix,
+			)
+		}
+	}
+}
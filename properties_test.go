@@ -0,0 +1,85 @@
+package twerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArbitraryPropertyConflicts(t *testing.T) {
+	testMerges(t, Default(), []mergeTest{
+		{
+			in:  "block [display:flex]",
+			out: "[display:flex]",
+		}, {
+			in:  "[display:flex] hidden",
+			out: "hidden",
+		}, {
+			in:  "p-4 [padding:4px]",
+			out: "[padding:4px]",
+		}, {
+			in:  "[padding:4px] p-4",
+			out: "p-4",
+		}, {
+			in:  "px-2 pt-1 [padding:4px]",
+			out: "[padding:4px]",
+		}, {
+			// padding-left does not override the other sides of p-4
+			in:  "p-4 [padding-left:4px]",
+			out: "p-4 [padding-left:4px]",
+		}, {
+			in:  "[padding-left:4px] p-4",
+			out: "p-4",
+		}, {
+			in:  "[padding-left:4px] [padding:2px]",
+			out: "[padding:2px]",
+		}, {
+			in:  "hover:block [display:flex]",
+			out: "hover:block [display:flex]",
+		}, {
+			in:  "blur-sm grayscale [filter:none]",
+			out: "[filter:none]",
+		}, {
+			in:  "[--my-var:1] [display:flex] flex",
+			out: "[--my-var:1] flex",
+		},
+	})
+
+	decisions := Explain("p-4 [padding-left:4px] [padding:2px]")
+	if decisions[0].OverriddenBy != 2 || decisions[1].OverriddenBy != 2 {
+		t.Errorf("Explain() should report [padding:2px] as the winner: %+v", decisions)
+	}
+}
+
+func TestProperties(t *testing.T) {
+	tt := []struct {
+		in  string
+		out []string
+	}{
+		{in: "hidden", out: []string{"display"}},
+		{in: "hover:px-4", out: []string{"padding-left", "padding-right"}},
+		{in: "size-4", out: []string{"width", "height"}},
+		{in: "[mask-type:luminance]", out: []string{"mask-type"}},
+		{in: "bg-red-500/50", out: []string{"background-color"}},
+		{in: "custom"},
+		{in: "hover:"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.in, func(t *testing.T) {
+			if got := Properties(tc.in); !reflect.DeepEqual(got, tc.out) {
+				t.Errorf("Properties(%q) = %v, want %v", tc.in, got, tc.out)
+			}
+		})
+	}
+
+	cfg := ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{
+			ClassGroups: map[string][]ClassDefinition{
+				"tab-size": {{Class: "tab", Validator: IsInteger}},
+			},
+			Properties: ClassGroupProperties{"tab-size": {"tab-size"}},
+		},
+	})
+	if got := New(NewHandler(cfg)).Merge("tab-2 [tab-size:8]"); got != "[tab-size:8]" {
+		t.Errorf("extended properties should conflict with arbitrary properties: %s", got)
+	}
+}
@@ -0,0 +1,262 @@
+package twerge
+
+import (
+	"slices"
+	"strings"
+)
+
+// WithoutRedundantVariants makes the [Generator] drop the responsive
+// classes that repeat the value of the smaller breakpoint they override
+// -> p-4 sm:p-4 md:p-6 lg:p-6 becomes p-4 md:p-6.
+//
+// It applies to [Generator.Merge] and to the merged classes written by
+// [CodeGen].
+func WithoutRedundantVariants() Option {
+	return func(g *Generator) {
+		g.optimizations = append(g.optimizations, (*defaultHandler).dropRedundantVariants)
+	}
+}
+
+// breakpoints are the min-width breakpoints of Tailwind CSS from the
+// smallest to the largest.
+var breakpoints = []string{"sm", "md", "lg", "xl", "2xl"}
+
+// breakpointKind is how the responsive variants of a class apply.
+type breakpointKind int
+
+const (
+	// noBreakpoint classes apply at every width
+	noBreakpoint breakpointKind = iota
+	// minBreakpoint classes apply from their breakpoint on -> md:p-4
+	minBreakpoint
+	// maxBreakpoint classes apply below their breakpoint -> max-md:p-4
+	maxBreakpoint
+	// otherBreakpoint classes have breakpoints that are not understood,
+	// like min-[900px] or sm:max-lg
+	otherBreakpoint
+)
+
+// responsiveItem is a merged class with its responsive variants split
+// from its other modifiers.
+type responsiveItem struct {
+	group int32
+	// rank is the index of the breakpoint in breakpoints plus one, 0
+	// without a breakpoint
+	kind breakpointKind
+	rank int
+	// scope holds the other modifiers, a class only repeats the value of a
+	// class of the same scope
+	scope scopeKey
+	// variants are the other modifiers of the class
+	variants []string
+	utility  string
+	dropped  bool
+}
+
+// dropRedundantVariants drops the responsive classes that can not change
+// the computed style:
+//
+//   - a min-width class that repeats the value of the class of the next
+//     smaller breakpoint, or of the class without a breakpoint -> sm:p-4
+//     of p-4 sm:p-4
+//   - a max-width class that repeats the value of the class without a
+//     breakpoint -> max-md:p-4 of p-4 max-md:p-4
+//
+// A class is kept when a class of a conflicting class group applies in
+// between -> lg:p-4 of p-4 md:px-2 lg:p-4, or applies with other variants
+// at its breakpoint -> md:p-4 of p-4 hover:p-2 md:p-4.
+func (g *defaultHandler) dropRedundantVariants(classes string) string {
+	decisions, _ := g.parseAll(classes)
+	table := g.table()
+	state := newMergeState()
+	items := make([]responsiveItem, len(decisions))
+	for i, decision := range decisions {
+		items[i] = g.responsiveItem(state, table, decision)
+	}
+
+	// smaller breakpoints first, so that a dropped class is compared
+	// through the class it repeats
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return items[a].rank - items[b].rank
+	})
+
+	dropped := false
+	for _, i := range order {
+		item := &items[i]
+		if item.group == noGroup || item.kind == noBreakpoint || item.kind == otherBreakpoint {
+			continue
+		}
+		prev := item.previous(items)
+		if prev == -1 || items[prev].utility != item.utility ||
+			item.blocked(items, prev, table, state.arbitrary) {
+			continue
+		}
+		item.dropped = true
+		dropped = true
+	}
+	if !dropped {
+		return classes
+	}
+
+	kept := make([]string, 0, len(decisions))
+	for i, decision := range decisions {
+		if !items[i].dropped {
+			kept = append(kept, decision.Class)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+// responsiveItem splits the responsive variants of a merged class from its
+// other modifiers.
+func (g *defaultHandler) responsiveItem(
+	state *mergeState,
+	table *classTable,
+	decision Decision,
+) responsiveItem {
+	item := responsiveItem{group: state.group(table, decision)}
+	if item.group == noGroup {
+		return item
+	}
+	var others []string
+	for _, modifier := range decision.Modifiers {
+		kind, rank := g.config.breakpoint(modifier)
+		switch {
+		case kind == noBreakpoint:
+			others = append(others, modifier)
+		case item.kind != noBreakpoint:
+			item.kind = otherBreakpoint
+		default:
+			item.kind, item.rank = kind, rank
+		}
+	}
+	item.variants = others
+	item.scope = scopeKey{
+		modifiers: strings.Join(
+			g.config.sortModifiers(slices.Clone(others)),
+			string(g.config.ModifierSeparator),
+		),
+		important: decision.Important,
+	}
+	_, item.utility, _ = g.splitUtility(decision)
+	return item
+}
+
+// breakpoint returns how a modifier applies as a responsive variant, and
+// the rank of its breakpoint.
+func (c *Config) breakpoint(modifier string) (breakpointKind, int) {
+	name, isMax := strings.CutPrefix(modifier, "max-")
+	if i := slices.Index(breakpoints, name); i != -1 {
+		if isMax {
+			return maxBreakpoint, i + 1
+		}
+		return minBreakpoint, i + 1
+	}
+	if isMax || strings.HasPrefix(modifier, "min-") ||
+		slices.Contains(c.Theme[ThemeBreakpoint], modifier) {
+		return otherBreakpoint, 0
+	}
+	return noBreakpoint, 0
+}
+
+// previous returns the index of the kept class whose value the class
+// overrides, or -1.
+//
+// It is the class of the same group and scope with the largest smaller
+// breakpoint for a min-width class, and the class without a breakpoint
+// for a max-width class.
+func (item *responsiveItem) previous(items []responsiveItem) int {
+	prev := -1
+	for j, other := range items {
+		if other.dropped || other.group != item.group || other.scope != item.scope {
+			continue
+		}
+		switch {
+		case other.kind == noBreakpoint && prev == -1:
+			prev = j
+		case item.kind == minBreakpoint && other.kind == minBreakpoint &&
+			other.rank < item.rank && (prev == -1 || other.rank > items[prev].rank):
+			prev = j
+		}
+	}
+	return prev
+}
+
+// blocked returns true if a class of the same or a conflicting class group
+// applies between the class and the one at prev, so that the class is
+// needed to restore its value.
+//
+// A class with other variants blocks wherever it applies with the class,
+// as the rule of the class comes after it -> dark:bg-black of bg-white
+// dark:bg-black md:bg-white.
+func (item *responsiveItem) blocked(
+	items []responsiveItem,
+	prev int,
+	table *classTable,
+	arbitrary []string,
+) bool {
+	for j, other := range items {
+		if j == prev || &items[j] == item || other.dropped ||
+			other.group == noGroup || other.scope.important != item.scope.important {
+			continue
+		}
+		if !table.claims(item.group, true, other.group, arbitrary) &&
+			!table.claims(other.group, true, item.group, arbitrary) {
+			continue
+		}
+		if other.scope != item.scope {
+			if !item.outranks(&other) && item.overlaps(&other) {
+				return true
+			}
+			continue
+		}
+		switch other.kind {
+		case noBreakpoint:
+			// overrides the class at prev at every width, while the
+			// classes with a breakpoint override it
+			if items[prev].kind == noBreakpoint {
+				return true
+			}
+		case minBreakpoint:
+			if item.kind == maxBreakpoint ||
+				other.rank > items[prev].rank && other.rank <= item.rank {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// outranks returns true if the variants of the other class are a subset
+// of the variants of the class, so that the class at prev already overrides
+// it wherever the class applies -> p-2 of hover:p-4 md:hover:p-4.
+func (item *responsiveItem) outranks(other *responsiveItem) bool {
+	if len(other.variants) >= len(item.variants) {
+		return false
+	}
+	for _, variant := range other.variants {
+		if !slices.Contains(item.variants, variant) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlaps returns true if the other class applies at some of the widths
+// of the class, a class with a larger min-width breakpoint overrides the
+// class either way.
+func (item *responsiveItem) overlaps(other *responsiveItem) bool {
+	if other.kind != minBreakpoint {
+		return true
+	}
+	if item.kind == maxBreakpoint {
+		return other.rank < item.rank
+	}
+	return other.rank <= item.rank
+}
@@ -0,0 +1,65 @@
+package twerge
+
+import "testing"
+
+func TestWithoutRedundantVariants(t *testing.T) {
+	g := New(NewHandler(nil), WithoutRedundantVariants())
+	testMerges(t, g, []mergeTest{
+		{
+			in:  "p-4 sm:p-4 md:p-6 lg:p-6",
+			out: "p-4 md:p-6",
+		}, {
+			in:  "lg:text-lg text-sm md:text-lg",
+			out: "text-sm md:text-lg",
+		}, {
+			in:  "hover:bg-red-500 md:hover:bg-red-500 md:bg-red-500",
+			out: "hover:bg-red-500 md:bg-red-500",
+		}, {
+			// max-md:p-4 wins over hover:p-2 below md
+			in:  "p-4 max-md:p-4 hover:p-2",
+			out: "p-4 max-md:p-4 hover:p-2",
+		}, {
+			in:  "p-4 max-md:p-4 md:hover:p-2",
+			out: "p-4 md:hover:p-2",
+		}, {
+			// md:bg-white wins over dark:bg-black from md in dark mode
+			in:  "bg-white dark:bg-black md:bg-white",
+			out: "bg-white dark:bg-black md:bg-white",
+		}, {
+			in:  "p-4 hover:p-2 md:p-4",
+			out: "p-4 hover:p-2 md:p-4",
+		}, {
+			// hover:p-4 already overrides p-2 wherever md:hover:p-4 applies
+			in:  "p-2 hover:p-4 md:hover:p-4",
+			out: "p-2 hover:p-4",
+		}, {
+			// lg:dark:bg-black overrides md:bg-white either way
+			in:  "bg-white lg:dark:bg-black md:bg-white",
+			out: "bg-white lg:dark:bg-black",
+		}, {
+			// max-md:p-4 wins over max-lg:p-2 below md
+			in:  "p-4 max-md:p-4 max-lg:p-2",
+			out: "p-4 max-md:p-4 max-lg:p-2",
+		}, {
+			in:  "flex sm:block md:block",
+			out: "flex sm:block",
+		}, {
+			// md:px-2 applies between p-4 and lg:p-4
+			in:  "p-4 md:px-2 lg:p-4",
+			out: "p-4 md:px-2 lg:p-4",
+		}, {
+			// px-2 overrides p-4 at every width, but not sm:p-4
+			in:  "p-4 px-2 sm:p-4",
+			out: "p-4 px-2 sm:p-4",
+		}, {
+			in:  "sm:p-4 px-2 md:p-4",
+			out: "sm:p-4 px-2",
+		}, {
+			in:  "p-4 sm:!p-4 min-[900px]:p-4 sm:max-lg:p-4",
+			out: "p-4 sm:!p-4 min-[900px]:p-4 sm:max-lg:p-4",
+		}, {
+			in:  "custom sm:custom p-4",
+			out: "custom sm:custom p-4",
+		},
+	})
+}
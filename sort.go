@@ -0,0 +1,136 @@
+package twerge
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Sort returns the classes in the canonical order of Tailwind CSS, the
+// order of prettier-plugin-tailwindcss:
+//
+//   - classes that are not Tailwind classes come first
+//   - classes without variants come before the ones with variants, which
+//     are ordered by their variants -> p-4 hover:p-2 md:p-1
+//   - classes with the same variants are ordered by class group, layout
+//     first, then the box model, typography and visual effects
+//
+// Classes of the same order keep their relative order, and no class is
+// dropped.
+//
+// Example: Sort("text-red-500 hover:underline p-4 flex") -> flex p-4
+// text-red-500 hover:underline
+func Sort(classes string) string {
+	return Default().Sort(classes)
+}
+
+// Sort returns the classes in the canonical order of Tailwind CSS.
+func (g *Generator) Sort(classes string) string {
+	return g.mergeHandler().Sort(classes)
+}
+
+// WithSortedClasses makes the [Generator] store the merged classes in the
+// canonical order of [Sort].
+//
+// It applies to [Generator.Merge] and to the merged classes written by
+// [CodeGen], after the other optimizations.
+func WithSortedClasses() Option {
+	return func(g *Generator) {
+		g.sorted = true
+	}
+}
+
+// classGroupRanks returns the indices of the class groups in
+// classGroupOrder, built on first use.
+var classGroupRanks = sync.OnceValue(func() map[string]int {
+	ranks := make(map[string]int, len(classGroupOrder))
+	for i, groupID := range classGroupOrder {
+		ranks[groupID] = i
+	}
+	return ranks
+})
+
+// sortKey is the position of a class in the canonical order.
+type sortKey struct {
+	tailwind bool
+	// indices of the variants of the class in Config.Variants, the
+	// largest first
+	variants []int
+	// group is the rank of the class group, the class groups that are not
+	// in classGroupOrder come after the others
+	group int
+}
+
+// Sort returns the classes in the canonical order of Tailwind CSS.
+func (g *defaultHandler) Sort(classes string) string {
+	decisions, _ := g.parseAll(classes)
+	keys := make([]sortKey, len(decisions))
+	for i, decision := range decisions {
+		keys[i] = g.sortKey(decision)
+	}
+
+	order := make([]int, len(decisions))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return compareSortKeys(keys[a], keys[b])
+	})
+
+	sorted := make([]string, len(order))
+	for i, idx := range order {
+		sorted[i] = decisions[idx].Class
+	}
+	return strings.Join(sorted, " ")
+}
+
+// sortKey returns the position of a class in the canonical order.
+func (g *defaultHandler) sortKey(decision Decision) sortKey {
+	if !decision.IsTailwind {
+		return sortKey{}
+	}
+	key := sortKey{tailwind: true, group: len(classGroupOrder)}
+	if rank, ok := classGroupRanks()[decision.GroupID]; ok {
+		key.group = rank
+	} else if _, ok := arbitraryProperty(decision.GroupID); ok {
+		// arbitrary properties come after the utilities of plugins
+		key.group++
+	}
+	for _, modifier := range decision.Modifiers {
+		key.variants = append(key.variants, g.config.variantIndex(modifier))
+	}
+	slices.SortFunc(key.variants, func(a, b int) int { return cmp.Compare(b, a) })
+	return key
+}
+
+// variantIndex returns the index of the variant in the known variants, or
+// their number for arbitrary and unknown variants.
+func (c *Config) variantIndex(modifier string) int {
+	for i := range c.Variants {
+		if matchModifier(c.Variants[i:i+1], modifier) {
+			return i
+		}
+	}
+	return len(c.Variants)
+}
+
+// compareSortKeys orders classes like Tailwind CSS orders their rules,
+// variants are compared from the last one like the bits of a number.
+func compareSortKeys(a, b sortKey) int {
+	if a.tailwind != b.tailwind {
+		if a.tailwind {
+			return 1
+		}
+		return -1
+	}
+	for i := 0; i < len(a.variants) && i < len(b.variants); i++ {
+		if c := cmp.Compare(a.variants[i], b.variants[i]); c != 0 {
+			return c
+		}
+	}
+	if c := cmp.Compare(len(a.variants), len(b.variants)); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.group, b.group)
+}
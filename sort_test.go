@@ -0,0 +1,50 @@
+package twerge
+
+import "testing"
+
+func TestSort(t *testing.T) {
+	tt := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "text-red-500 hover:underline p-4 flex",
+			out: "flex p-4 text-red-500 hover:underline",
+		}, {
+			in:  "md:p-1 hover:p-2 p-4 custom",
+			out: "custom p-4 hover:p-2 md:p-1",
+		}, {
+			in:  "md:hover:p-2 hover:md:p-1 md:p-4",
+			out: "md:p-4 md:hover:p-2 hover:md:p-1",
+		}, {
+			in:  "[mask-type:luminance] bg-red-500 w-4 mt-2 absolute",
+			out: "absolute mt-2 w-4 bg-red-500 [mask-type:luminance]",
+		}, {
+			in:  "  p-4   p-2 ",
+			out: "p-4 p-2",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.in, func(t *testing.T) {
+			if got := Sort(tc.in); got != tc.out {
+				t.Errorf("Sort(%q) = %q, want %q", tc.in, got, tc.out)
+			}
+		})
+	}
+
+	g := New(NewHandler(nil), WithSortedClasses(), WithShorthands())
+	if got := g.Merge("pl-4 text-lg pr-4 block"); got != "block px-4 text-lg" {
+		t.Errorf("Merge() = %q, want the merged classes in canonical order", got)
+	}
+	g.It("pl-4 text-lg pr-4 block")
+	g.optimizeCache()
+	if got := g.Handler.Cache()["pl-4 text-lg pr-4 block"].Merged; got != "block px-4 text-lg" {
+		t.Errorf("cached Merged = %q, want block px-4 text-lg", got)
+	}
+
+	for _, groupID := range defaultConfigV4().table().groupIDs {
+		if _, ok := classGroupRanks()[groupID]; !ok {
+			t.Errorf("class group %s is not in classGroupOrder", groupID)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package twerge
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LoadStylesheet reads a stylesheet compiled by Tailwind CSS and returns
+// base extended with the utilities of the stylesheet it does not know.
+//
+// If base is nil, the default configuration is used, and a base whose
+// Version is V4 gets the class groups of v4 like in [NewHandler].
+func LoadStylesheet(base *Config, path string) (*Config, error) {
+	css, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading css file: %w", err)
+	}
+	base = baseConfig(base)
+	ext, err := ParseStylesheet(base, string(css))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return MergeConfig(base, ext), nil
+}
+
+// ParseStylesheet returns the utilities of a compiled stylesheet that base
+// does not know as a [ConfigExtension].
+//
+// Every utility is mapped to the css properties it sets:
+//
+//   - utilities that set the same properties as a class group of base
+//     join it
+//   - other utilities that set the same properties share a new class group
+//   - a utility overrides the class groups that set a subset of its
+//     properties -> a utility setting margin-left and margin-right
+//     overrides one setting margin-left
+//
+// If base is nil, the default configuration is used, and a base whose
+// Version is V4 gets the class groups of v4 like in [NewHandler].
+func ParseStylesheet(base *Config, css string) (ConfigExtension, error) {
+	base = baseConfig(base)
+	rules, err := parseCSSRules(css)
+	if err != nil {
+		return ConfigExtension{}, err
+	}
+	h := &defaultHandler{config: base}
+	utilities := make(map[string][]string)
+	h.collectUtilities(rules, utilities)
+
+	var (
+		// css properties of the known class groups and the new utilities
+		groupProperties = make(map[string][]string)
+		newUtilities    = make(map[string][]string)
+	)
+	for _, class := range slices.Sorted(maps.Keys(utilities)) {
+		properties := utilities[class]
+		if isTw, groupID := h.getClassGroupID(class); isTw {
+			groupProperties[groupID] = union(groupProperties[groupID], properties)
+			continue
+		}
+		newUtilities[class] = properties
+	}
+
+	// known class groups by their properties
+	known := make(map[string]string)
+	for _, groupID := range slices.Sorted(maps.Keys(groupProperties)) {
+		key := strings.Join(groupProperties[groupID], ",")
+		if _, ok := known[key]; !ok {
+			known[key] = groupID
+		}
+	}
+
+	ext := ConfigExtension{Extend: ConfigGroups{
+		ClassGroups:            make(map[string][]ClassDefinition),
+		ConflictingClassGroups: make(ConflictingClassGroups),
+		Properties:             make(ClassGroupProperties),
+	}}
+	var newGroups []string
+	for _, class := range slices.Sorted(maps.Keys(newUtilities)) {
+		properties := newUtilities[class]
+		groupID, ok := known[strings.Join(properties, ",")]
+		if !ok {
+			groupID = utilityGroupID(properties)
+			if _, ok := groupProperties[groupID]; !ok {
+				groupProperties[groupID] = properties
+				newGroups = append(newGroups, groupID)
+				if standard := standardProperties(properties); standard != nil {
+					ext.Extend.Properties[groupID] = standard
+				}
+			}
+		}
+		ext.Extend.ClassGroups[groupID] = append(
+			ext.Extend.ClassGroups[groupID],
+			ClassDefinition{Class: class},
+		)
+	}
+
+	for _, groupID := range newGroups {
+		for _, other := range slices.Sorted(maps.Keys(groupProperties)) {
+			switch {
+			case isStrictSubset(groupProperties[other], groupProperties[groupID]):
+				ext.Extend.ConflictingClassGroups[groupID] = append(
+					ext.Extend.ConflictingClassGroups[groupID],
+					other,
+				)
+			case isStrictSubset(groupProperties[groupID], groupProperties[other]) &&
+				!slices.Contains(newGroups, other):
+				// the new group is added to the conflicts of the known one,
+				// the conflicts between new groups are added above
+				ext.Extend.ConflictingClassGroups[other] = append(
+					ext.Extend.ConflictingClassGroups[other],
+					groupID,
+				)
+			}
+		}
+	}
+	return ext, nil
+}
+
+// utilityGroupID returns the ID of the class group of the utilities that
+// set the sorted css properties.
+func utilityGroupID(properties []string) string {
+	return "utility." + strings.Join(properties, ",")
+}
+
+// collectUtilities adds the css properties set by the utility of every
+// style rule to utilities, by utility class without its variants.
+//
+// At-rules like @media and @layer are walked, while @keyframes, @property
+// and the rules without a class like :root are skipped.
+func (g *defaultHandler) collectUtilities(rules []cssRule, utilities map[string][]string) {
+	for _, rule := range rules {
+		if !rule.block {
+			continue
+		}
+		if name, _, _ := strings.Cut(rule.prelude, " "); strings.HasPrefix(name, "@") {
+			switch name {
+			case "@keyframes", "@property", "@font-face", "@theme":
+				continue
+			}
+			nested, _ := parseCSSRules(rule.body)
+			g.collectUtilities(nested, utilities)
+			continue
+		}
+		properties := declaredProperties(rule.body)
+		if len(properties) == 0 {
+			continue
+		}
+		for _, selector := range splitSelectors(rule.prelude) {
+			class := g.utilityClass(selector)
+			if class == "" {
+				continue
+			}
+			utilities[class] = union(utilities[class], properties)
+		}
+	}
+}
+
+// declaredProperties returns the sorted css properties of the declarations
+// of a rule body, including the ones of nested rules like &:hover { ... }.
+func declaredProperties(body string) []string {
+	var properties []string
+	// the body was matched, so it is balanced
+	nested, _ := parseCSSRules(body)
+	for _, rule := range nested {
+		if rule.block {
+			properties = union(properties, declaredProperties(rule.body))
+			continue
+		}
+		property, _, ok := strings.Cut(rule.prelude, ":")
+		property = strings.ToLower(strings.TrimSpace(property))
+		if !ok || property == "" || strings.HasPrefix(property, "@") {
+			continue
+		}
+		properties = union(properties, []string{property})
+	}
+	return properties
+}
+
+// splitSelectors splits a selector list on the commas outside of
+// parentheses and brackets.
+func splitSelectors(prelude string) []string {
+	var (
+		selectors []string
+		depth     int
+		start     int
+	)
+	for i := 0; i < len(prelude); i++ {
+		switch prelude[i] {
+		case '\\':
+			i++
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				selectors = append(selectors, prelude[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(selectors, prelude[start:])
+}
+
+// utilityClass returns the utility of a selector without its variants,
+// important modifier and prefix, it is the class with the most variants ->
+// p-4 of .group:hover .group-hover\:p-4.
+//
+// Classes without the prefix of the config are not utilities.
+func (g *defaultHandler) utilityClass(selector string) string {
+	var (
+		utility   string
+		modifiers = -1
+	)
+	for i := 0; i < len(selector); i++ {
+		if selector[i] == '\\' {
+			i++
+			continue
+		}
+		if selector[i] != '.' {
+			continue
+		}
+		class, n := unescapeClass(selector[i+1:])
+		i += n
+		decision, err := g.parse(class, nil)
+		if err != nil || len(decision.Modifiers) <= modifiers {
+			continue
+		}
+		if _, ok := g.config.trimVariantPrefix(class); !ok {
+			continue
+		}
+		_, base, _ := g.splitUtility(decision)
+		if !g.config.isVariantPrefix() {
+			var ok bool
+			if base, ok = g.config.trimClassPrefix(base); !ok {
+				continue
+			}
+		}
+		modifiers = len(decision.Modifiers)
+		utility = base
+	}
+	return utility
+}
+
+// unescapeClass returns the class name at the start of a selector and the
+// number of bytes it spans -> hover:p-4 of hover\:p-4:hover.
+func unescapeClass(selector string) (string, int) {
+	var b strings.Builder
+	i := 0
+	for i < len(selector) {
+		char := selector[i]
+		switch {
+		case char == '\\' && i+1 < len(selector):
+			// hex escapes -> \32 xl is 2xl
+			hex := 0
+			for hex < 6 && i+1+hex < len(selector) && isHexDigit(selector[i+1+hex]) {
+				hex++
+			}
+			if hex == 0 {
+				b.WriteByte(selector[i+1])
+				i += 2
+				continue
+			}
+			r, _ := strconv.ParseUint(selector[i+1:i+1+hex], 16, 32)
+			b.WriteRune(rune(r))
+			i += 1 + hex
+			if i < len(selector) && selector[i] == ' ' {
+				i++
+			}
+		case char == '-' || char == '_' || char >= 0x80 ||
+			'a' <= char|0x20 && char|0x20 <= 'z' || '0' <= char && char <= '9':
+			b.WriteByte(char)
+			i++
+		default:
+			return b.String(), i
+		}
+	}
+	return b.String(), i
+}
+
+func isHexDigit(char byte) bool {
+	return '0' <= char && char <= '9' || 'a' <= char|0x20 && char|0x20 <= 'f'
+}
+
+// union returns the sorted css properties of a and b.
+func union(a, b []string) []string {
+	merged := slices.Clone(a)
+	for _, property := range b {
+		if !slices.Contains(merged, property) {
+			merged = append(merged, property)
+		}
+	}
+	slices.Sort(merged)
+	return merged
+}
+
+// isStrictSubset returns true if every property of a is in b and b has
+// more properties.
+func isStrictSubset(a, b []string) bool {
+	if len(a) >= len(b) {
+		return false
+	}
+	for _, property := range a {
+		if !slices.Contains(b, property) {
+			return false
+		}
+	}
+	return true
+}
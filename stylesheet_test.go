@@ -0,0 +1,235 @@
+package twerge
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testStylesheet = `/*! tailwindcss v4.1.0 | MIT License | https://tailwindcss.com */
+@layer theme {
+	:root, :host {
+		--color-red-500: oklch(63.7% 0.237 25.331);
+		--spacing: 0.25rem;
+	}
+}
+@layer utilities {
+	.mx-4 {
+		margin-inline: calc(var(--spacing) * 4);
+	}
+	.btn-x {
+		margin-inline: 1rem;
+	}
+	.glow-sm {
+		text-shadow: 0 0 4px var(--color-red-500);
+	}
+	.glow-none {
+		text-shadow: none;
+	}
+	.scrollbar-thin {
+		scrollbar-width: thin;
+	}
+	.scrollbar-thin-red {
+		scrollbar-width: thin;
+		scrollbar-color: var(--color-red-500) transparent;
+	}
+	.hover\:glow-sm {
+		&:hover {
+			@media (hover: hover) {
+				text-shadow: 0 0 4px var(--color-red-500);
+			}
+		}
+	}
+	.\32xl\:scrollbar-none {
+		@media (width >= 96rem) {
+			scrollbar-width: none;
+		}
+	}
+	.group:hover .group-hover\:scrollbar-none, :where(.peer:checked ~ .peer-checked\:scrollbar-none) {
+		scrollbar-width: none;
+	}
+}
+@keyframes spin {
+	to {
+		transform: rotate(360deg);
+	}
+}
+@property --tw-shadow {
+	syntax: "*";
+	inherits: false;
+}
+`
+
+func TestParseStylesheet(t *testing.T) {
+	ext, err := ParseStylesheet(nil, testStylesheet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantGroups := map[string][]ClassDefinition{
+		"mx":                      {{Class: "btn-x"}},
+		"utility.text-shadow":     {{Class: "glow-none"}, {Class: "glow-sm"}},
+		"utility.scrollbar-width": {{Class: "scrollbar-none"}, {Class: "scrollbar-thin"}},
+		"utility.scrollbar-color,scrollbar-width": {{Class: "scrollbar-thin-red"}},
+	}
+	if !reflect.DeepEqual(ext.Extend.ClassGroups, wantGroups) {
+		t.Errorf("ClassGroups = %v, want %v", ext.Extend.ClassGroups, wantGroups)
+	}
+	wantConflicts := ConflictingClassGroups{
+		"utility.scrollbar-color,scrollbar-width": {"utility.scrollbar-width"},
+	}
+	if !reflect.DeepEqual(ext.Extend.ConflictingClassGroups, wantConflicts) {
+		t.Errorf("ConflictingClassGroups = %v, want %v", ext.Extend.ConflictingClassGroups, wantConflicts)
+	}
+	wantProperties := ClassGroupProperties{
+		"utility.text-shadow":                     {"text-shadow"},
+		"utility.scrollbar-width":                 {"scrollbar-width"},
+		"utility.scrollbar-color,scrollbar-width": {"scrollbar-color", "scrollbar-width"},
+	}
+	if !reflect.DeepEqual(ext.Extend.Properties, wantProperties) {
+		t.Errorf("Properties = %v, want %v", ext.Extend.Properties, wantProperties)
+	}
+}
+
+func TestLoadStylesheet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output.css")
+	if err := os.WriteFile(path, []byte(testStylesheet), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadStylesheet(nil, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := New(NewHandler(cfg))
+	// utilities of the stylesheet join a known class group or their own,
+	// with the variants they were compiled with
+	testGroupOf(t, g, map[string]string{
+		"btn-x":                      "mx",
+		"hover:glow-sm":              "utility.text-shadow",
+		"2xl:scrollbar-none":         "utility.scrollbar-width",
+		"scrollbar-thin-red":         "utility.scrollbar-color,scrollbar-width",
+		"group-hover:scrollbar-none": "utility.scrollbar-width",
+	})
+	// the utility setting more properties overrides the other, not the
+	// other way around
+	testMerges(t, g, []mergeTest{
+		{in: "scrollbar-none scrollbar-thin-red", out: "scrollbar-thin-red"},
+		{in: "scrollbar-thin-red scrollbar-none", out: "scrollbar-thin-red scrollbar-none"},
+	})
+
+	if _, err := LoadStylesheet(nil, filepath.Join(t.TempDir(), "missing.css")); err == nil {
+		t.Error("LoadStylesheet() should fail for a missing file")
+	}
+}
+
+func TestSplitSelectors(t *testing.T) {
+	got := splitSelectors(`.a, :where(.b, .c) .d\,e, [data-x="1,2"]`)
+	want := []string{".a", " :where(.b, .c) .d\\,e", ` [data-x="1,2"]`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitSelectors() = %q, wanted %q", got, want)
+	}
+}
+
+func TestUnescapeClass(t *testing.T) {
+	tt := map[string]string{
+		`hover\:p-4:hover`:     "hover:p-4",
+		`p-1\.5 > *`:           "p-1.5",
+		`\32xl\:p-4`:           "2xl:p-4",
+		`\31 0\/12`:            "10/12",
+		`w-\[10px\]`:           "w-[10px]",
+		`\[mask-type\:alpha\]`: "[mask-type:alpha]",
+	}
+	for selector, want := range tt {
+		if got, _ := unescapeClass(selector); got != want {
+			t.Errorf("unescapeClass(%q) = %q, want %q", selector, got, want)
+		}
+	}
+}
+
+func TestParseStylesheetPrefix(t *testing.T) {
+	tt := []struct {
+		prefix string
+		css    string
+	}{
+		{
+			prefix: "tw-",
+			css: `.tw-p-4 { padding: 1rem; }
+.tw-scrollbar-thin { scrollbar-width: thin; }
+.hover\:\!tw-scrollbar-none:hover { scrollbar-width: none !important; }
+.custom { scrollbar-width: auto; }`,
+		}, {
+			prefix: "tw:",
+			css: `.tw\:p-4 { padding: 1rem; }
+.tw\:scrollbar-thin { scrollbar-width: thin; }
+.tw\:hover\:scrollbar-none\!:hover { scrollbar-width: none !important; }
+.custom { scrollbar-width: auto; }`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.prefix, func(t *testing.T) {
+			base := DefaultConfig()
+			base.Prefix = tc.prefix
+			ext, err := ParseStylesheet(base, tc.css)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := map[string][]ClassDefinition{
+				"utility.scrollbar-width": {{Class: "scrollbar-none"}, {Class: "scrollbar-thin"}},
+			}
+			if !reflect.DeepEqual(ext.Extend.ClassGroups, want) {
+				t.Errorf("ClassGroups = %v, want %v", ext.Extend.ClassGroups, want)
+			}
+			testMerges(t, New(NewHandler(MergeConfig(base, ext))), []mergeTest{{
+				in:  tc.prefix + "scrollbar-thin " + tc.prefix + "scrollbar-none",
+				out: tc.prefix + "scrollbar-none",
+			}})
+		})
+	}
+}
+
+func TestLoadersBase(t *testing.T) {
+	dir := t.TempDir()
+	stylesheet := filepath.Join(dir, "output.css")
+	css := ".inset-shadow-sm { box-shadow: inset 0 1px 1px #0000000d; }\n" +
+		".glow-sm { filter: drop-shadow(0 0 2px #fff); }"
+	if err := os.WriteFile(stylesheet, []byte(css), 0644); err != nil {
+		t.Fatal(err)
+	}
+	input := filepath.Join(dir, "input.css")
+	if err := os.WriteFile(input, []byte("@utility tab-4 { tab-size: 4; }"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// a V4 base built on the v3 class groups knows inset-shadow-sm
+	v4 := DefaultConfig()
+	v4.Version = V4
+	ext, err := ParseStylesheet(v4, css)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ext.Extend.ClassGroups["utility.box-shadow"]; ok {
+		t.Errorf("ClassGroups = %v, wanted inset-shadow-sm to be known", ext.Extend.ClassGroups)
+	}
+
+	for _, base := range []*Config{nil, v4} {
+		fromStylesheet, err := LoadStylesheet(base, stylesheet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromCSS, err := LoadCSS(base, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromStylesheet.Version != fromCSS.Version {
+			t.Errorf("Version = %v and %v with base %v, wanted the same default", fromStylesheet.Version, fromCSS.Version, base)
+		}
+		if base == nil {
+			continue
+		}
+		for _, cfg := range []*Config{fromStylesheet, fromCSS} {
+			if got := New(NewHandler(cfg)).GroupOf("inset-shadow-sm"); got != "inset-shadow" {
+				t.Errorf("GroupOf(inset-shadow-sm) = %q, wanted inset-shadow", got)
+			}
+		}
+	}
+}
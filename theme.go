@@ -0,0 +1,188 @@
+package twerge
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Theme maps a theme namespace to the names of the design tokens of a
+// project, like the theme of tailwind.config.js or the @theme variables of
+// Tailwind CSS v4.
+//
+// Class definitions with a Theme namespace match its tokens, so that
+// text-primary is a color while text-display is a font size.
+//
+// Example: Theme{ThemeColor: {"primary", "brand"}, ThemeText: {"display"}}
+type Theme map[string][]string
+
+// Theme namespaces used by the default configuration. They are named after
+// the css variables of Tailwind CSS v4 -> --color-primary.
+const (
+	// ThemeColor holds color names, a color also matches its shades ->
+	// brand matches bg-brand-500
+	ThemeColor = "color"
+	// ThemeSpacing holds spacing keys -> p-gutter
+	ThemeSpacing = "spacing"
+	// ThemeText holds font size keys -> text-display
+	ThemeText = "text"
+	// ThemeBreakpoint holds breakpoints, which are also known variants ->
+	// 3xl:p-4
+	ThemeBreakpoint = "breakpoint"
+	// ThemeRadius holds border radius keys -> rounded-card
+	ThemeRadius = "radius"
+	// ThemeShadow holds box shadow keys -> shadow-card
+	ThemeShadow = "shadow"
+	// ThemeFont holds font family keys -> font-display
+	ThemeFont = "font"
+	// ThemeFontWeight holds font weight keys -> font-heavy
+	ThemeFontWeight = "font-weight"
+	// ThemeTracking holds letter spacing keys -> tracking-snug
+	ThemeTracking = "tracking"
+	// ThemeLeading holds line height keys -> leading-snug
+	ThemeLeading = "leading"
+	// ThemeBlur holds blur keys -> blur-soft
+	ThemeBlur = "blur"
+	// ThemeDropShadow holds drop shadow keys -> drop-shadow-card
+	ThemeDropShadow = "drop-shadow"
+	// ThemeEase holds timing function keys -> ease-snappy
+	ThemeEase = "ease"
+	// ThemeAnimate holds animation keys -> animate-wiggle
+	ThemeAnimate = "animate"
+	// ThemeAspect holds aspect ratio keys -> aspect-retro
+	ThemeAspect = "aspect"
+	// ThemeContainer holds container size keys -> max-w-prose-wide
+	ThemeContainer = "container"
+)
+
+// clone returns a deep copy of the theme.
+func (t Theme) clone() Theme {
+	clone := make(Theme, len(t))
+	for namespace, tokens := range t {
+		clone[namespace] = slices.Clone(tokens)
+	}
+	return clone
+}
+
+// validator returns a validator that matches the tokens of the namespace.
+func (t Theme) validator(namespace string) func(string) bool {
+	tokens := make(map[string]bool, len(t[namespace]))
+	for _, token := range t[namespace] {
+		tokens[token] = true
+	}
+	if namespace != ThemeColor {
+		return func(val string) bool { return tokens[val] }
+	}
+	return func(val string) bool {
+		if tokens[val] {
+			return true
+		}
+		// shades -> brand-500
+		i := strings.LastIndexByte(val, '-')
+		return i != -1 && tokens[val[:i]] && isInteger(val[i+1:])
+	}
+}
+
+// overrideTheme replaces the tokens of the namespaces in src.
+func overrideTheme(dst, src Theme) {
+	for namespace, tokens := range src {
+		dst[namespace] = slices.Clone(tokens)
+	}
+}
+
+// extendTheme adds the tokens of the namespaces in src that are not yet in
+// dst.
+func extendTheme(dst, src Theme) {
+	for namespace, tokens := range src {
+		for _, token := range tokens {
+			if !slices.Contains(dst[namespace], token) {
+				dst[namespace] = append(dst[namespace], token)
+			}
+		}
+	}
+}
+
+// themeClasses are the classes of the default class groups whose values
+// come from the theme, by namespace and class group ID.
+var themeClasses = map[string]map[string]string{
+	ThemeColor: {
+		"bg-color":              "bg",
+		"text-color":            "text",
+		"border-color":          "border",
+		"border-color-x":        "border-x",
+		"border-color-y":        "border-y",
+		"border-color-t":        "border-t",
+		"border-color-r":        "border-r",
+		"border-color-b":        "border-b",
+		"border-color-l":        "border-l",
+		"divide-color":          "divide",
+		"outline-color":         "outline",
+		"ring-color":            "ring",
+		"ring-offset-color":     "ring-offset",
+		"shadow-color":          "shadow",
+		"fill":                  "fill",
+		"stroke":                "stroke",
+		"text-decoration-color": "decoration",
+		"accent":                "accent",
+		"caret-color":           "caret",
+		"placeholder-color":     "placeholder",
+		"gradient-from":         "from",
+		"gradient-via":          "via",
+		"gradient-to":           "to",
+	},
+	ThemeSpacing: sameClass(
+		"p", "px", "py", "ps", "pe", "pt", "pr", "pb", "pl",
+		"m", "mx", "my", "ms", "me", "mt", "mr", "mb", "ml",
+		"gap", "gap-x", "gap-y", "space-x", "space-y",
+		"inset", "inset-x", "inset-y", "start", "end",
+		"top", "right", "bottom", "left",
+		"w", "h", "size", "min-h", "max-h", "basis", "indent",
+		"translate-x", "translate-y",
+		"border-spacing", "border-spacing-x", "border-spacing-y",
+		"scroll-m", "scroll-mx", "scroll-my", "scroll-ms", "scroll-me",
+		"scroll-mt", "scroll-mr", "scroll-mb", "scroll-ml",
+		"scroll-p", "scroll-px", "scroll-py", "scroll-ps", "scroll-pe",
+		"scroll-pt", "scroll-pr", "scroll-pb", "scroll-pl",
+	),
+	ThemeText: {"font-size": "text"},
+	ThemeRadius: sameClass(
+		"rounded", "rounded-s", "rounded-e", "rounded-t", "rounded-r",
+		"rounded-b", "rounded-l", "rounded-ss", "rounded-se", "rounded-ee",
+		"rounded-es", "rounded-tl", "rounded-tr", "rounded-br", "rounded-bl",
+	),
+	ThemeShadow:     {"shadow": "shadow"},
+	ThemeBreakpoint: {"max-w": "max-w-screen"},
+	ThemeFont:       {"font-family": "font"},
+	ThemeFontWeight: {"font-weight": "font"},
+	ThemeTracking:   sameClass("tracking"),
+	ThemeLeading:    sameClass("leading"),
+	ThemeBlur:       sameClass("blur"),
+	ThemeDropShadow: sameClass("drop-shadow"),
+	ThemeEase:       sameClass("ease"),
+	ThemeAnimate:    sameClass("animate"),
+	ThemeAspect:     sameClass("aspect"),
+	ThemeContainer:  {"max-w": "max-w"},
+}
+
+// sameClass maps class group IDs to the class of the same name.
+func sameClass(groupIDs ...string) map[string]string {
+	classes := make(map[string]string, len(groupIDs))
+	for _, groupID := range groupIDs {
+		classes[groupID] = groupID
+	}
+	return classes
+}
+
+// withThemeClassGroups adds the theme validators of themeClasses to the
+// config, they are tried before the other validators of their class.
+func withThemeClassGroups(c *Config) *Config {
+	for _, namespace := range slices.Sorted(maps.Keys(themeClasses)) {
+		classes := themeClasses[namespace]
+		for _, groupID := range slices.Sorted(maps.Keys(classes)) {
+			c.addClassGroup(groupID, []ClassDefinition{
+				{Class: classes[groupID], Theme: namespace},
+			})
+		}
+	}
+	return c
+}
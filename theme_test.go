@@ -0,0 +1,95 @@
+package twerge
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestThemeValidator(t *testing.T) {
+	theme := Theme{ThemeColor: {"brand"}, ThemeText: {"display"}}
+	tt := []struct {
+		namespace string
+		val       string
+		want      bool
+	}{
+		{ThemeColor, "brand", true},
+		{ThemeColor, "brand-500", true},
+		{ThemeColor, "brand-dark", false},
+		{ThemeColor, "display", false},
+		{ThemeText, "display", true},
+		{ThemeText, "display-500", false},
+		{ThemeSpacing, "brand", false},
+	}
+	for _, tc := range tt {
+		if got := theme.validator(tc.namespace)(tc.val); got != tc.want {
+			t.Errorf("validator(%s)(%s) = %v, wanted %v", tc.namespace, tc.val, got, tc.want)
+		}
+	}
+}
+
+func TestThemeGroupOf(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{
+			Theme: Theme{
+				ThemeColor:   {"primary"},
+				ThemeText:    {"display"},
+				ThemeSpacing: {"gutter"},
+				ThemeRadius:  {"card"},
+				ThemeShadow:  {"card"},
+			},
+		},
+	})))
+	// the theme decides the class group of the classes sharing a prefix
+	testGroupOf(t, g, map[string]string{
+		"text-primary":       "text-color",
+		"text-display":       "font-size",
+		"p-gutter":           "p",
+		"rounded-card":       "rounded",
+		"shadow-card":        "shadow",
+		"shadow-primary":     "shadow-color",
+		"bg-primary-500":     "bg-color",
+		"text-display-lg":    "text-color",
+		"max-w-screen-3xl":   "max-w",
+		"hover:text-display": "font-size",
+	})
+	// without a theme text-display is a color
+	if got := GroupOf("text-display"); got != "text-color" {
+		t.Errorf("GroupOf(text-display) = %q without a theme, wanted text-color", got)
+	}
+}
+
+func TestThemeBreakpoints(t *testing.T) {
+	g := New(NewHandler(ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{Theme: Theme{ThemeBreakpoint: {"3xl"}}},
+	})))
+	if issues := g.Validate("3xl:p-4"); len(issues) != 0 {
+		t.Errorf("Validate() = %v, wanted theme breakpoints to be known variants", issues)
+	}
+	if issues := Validate("3xl:p-4"); len(issues) != 1 || issues[0].Kind != IssueUnknownVariant {
+		t.Errorf("Validate() = %v without a theme, wanted an unknown variant", issues)
+	}
+}
+
+func TestMergeConfigTheme(t *testing.T) {
+	base := ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{Theme: Theme{ThemeText: {"display", "title"}}},
+	})
+	extended := MergeConfig(base, ConfigExtension{
+		Extend: ConfigGroups{Theme: Theme{ThemeText: {"title", "caption"}}},
+	})
+	if want := []string{"display", "title", "caption"}; !slices.Equal(extended.Theme[ThemeText], want) {
+		t.Errorf("extended Theme = %v, wanted %v", extended.Theme[ThemeText], want)
+	}
+	overridden := MergeConfig(base, ConfigExtension{
+		Override: ConfigGroups{Theme: Theme{ThemeText: {"title"}}},
+	})
+	if want := []string{"title"}; !slices.Equal(overridden.Theme[ThemeText], want) {
+		t.Errorf("overridden Theme = %v, wanted %v", overridden.Theme[ThemeText], want)
+	}
+	if got := New(NewHandler(overridden)).GroupOf("text-display"); got != "text-color" {
+		t.Errorf("GroupOf(text-display) = %q, wanted the overridden token to be a color", got)
+	}
+	if want := []string{"display", "title"}; !slices.Equal(base.Theme[ThemeText], want) {
+		t.Errorf("base Theme = %v, wanted it unchanged", base.Theme[ThemeText])
+	}
+}
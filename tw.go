@@ -37,7 +37,14 @@ func CodeGen(
 		}
 	}
 
-	err := generateCSS(g, cssPath)
+	err := g.validateCache()
+	if err != nil {
+		return err
+	}
+
+	g.optimizeCache()
+
+	err = generateCSS(g, cssPath)
 	if err != nil {
 		return err
 	}
@@ -131,9 +138,10 @@ func generateHTML(
 	buf.WriteString("<div class=\"")
 	buf.WriteString("mb-4")
 	buf.WriteString("\"></div>\n")
-	for k := range len(g.Cache()) {
+	_, values := sortMap(g.Cache())
+	for _, value := range values {
 		buf.WriteString("<div class=\"")
-		buf.WriteString(fmt.Sprintf("tw-%d", k))
+		buf.WriteString(value.Generated)
 		buf.WriteString("\"></div>\n")
 	}
 
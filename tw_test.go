@@ -0,0 +1,94 @@
+package twerge
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// codeGen runs CodeGen with g and returns the written CSS and Go files.
+func codeGen(t *testing.T, g *Generator) (css, goSrc string, err error) {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "classes")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	goPath := filepath.Join(dir, "classes.go")
+	cssPath := filepath.Join(dir, "input.css")
+	if err := CodeGen(g, goPath, cssPath, filepath.Join(dir, "classes.html")); err != nil {
+		return "", "", err
+	}
+	cssData, err := os.ReadFile(cssPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	goData, err := os.ReadFile(goPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(cssData), string(goData), nil
+}
+
+func TestCodeGenGenerator(t *testing.T) {
+	// the generator is not the default one
+	g := New(NewHandler(nil), WithShorthands())
+	g.It("pl-4 pr-4 text-lg")
+	css, goSrc, err := codeGen(t, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(css, "/* from pl-4 pr-4 text-lg */\n.tw-0 { \n\t@apply px-4 text-lg; \n}") {
+		t.Errorf("CodeGen() css = %s, wanted the optimized classes of the generator", css)
+	}
+	if !strings.Contains(goSrc, `"pl-4 pr-4 text-lg": twerge.CacheValue{`) || !strings.Contains(goSrc, `Merged:    "px-4 text-lg"`) {
+		t.Errorf("CodeGen() go = %s, wanted the optimized classes of the generator", goSrc)
+	}
+}
+
+func TestCodeGenGeneratorValidation(t *testing.T) {
+	g := New(NewHandler(nil), WithValidation())
+	g.It("p-4 pading-4")
+	_, _, err := codeGen(t, g)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || len(verr.Issues) != 1 || verr.Issues[0].Class != "pading-4" {
+		t.Fatalf("CodeGen() error = %v, wanted the issue of the class of the generator", err)
+	}
+
+	// the classes that pass validation are the ones written
+	g = New(NewHandler(nil), WithValidation())
+	g.It("p-4 hover:p-2")
+	css, _, err := codeGen(t, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(css, "@apply p-4 hover:p-2;") || strings.Count(css, "@apply") != 1 {
+		t.Errorf("CodeGen() css = %s, wanted the validated classes only", css)
+	}
+}
+
+func TestCodeGenWithoutRedundantVariants(t *testing.T) {
+	g := New(NewHandler(nil), WithoutRedundantVariants())
+	g.It("p-4 sm:p-4 md:p-6")
+	css, goSrc, err := codeGen(t, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(css, "@apply p-4 md:p-6;") || !strings.Contains(goSrc, `Merged:    "p-4 md:p-6"`) {
+		t.Errorf("CodeGen() css = %s, wanted the redundant variants dropped", css)
+	}
+}
+
+func TestCodeGenWithSortedClasses(t *testing.T) {
+	g := New(NewHandler(nil), WithSortedClasses())
+	g.It("hover:underline text-lg custom block")
+	css, goSrc, err := codeGen(t, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "custom block text-lg hover:underline"
+	if !strings.Contains(css, "@apply "+want+";") || !strings.Contains(goSrc, `Merged:    "`+want+`"`) {
+		t.Errorf("CodeGen() css = %s, wanted the classes in canonical order", css)
+	}
+}
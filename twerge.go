@@ -1,11 +1,13 @@
 package twerge
 
 import (
+	"errors"
 	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"unicode/utf8"
 )
 
 // CacheValue contains the value of a cache entry.
@@ -25,14 +27,23 @@ type CacheValue struct {
 	Merged string
 }
 
-var defaultGenerator atomic.Pointer[Generator]
-
-func init() {
-	defaultGenerator.Store(New(newDefaultHandler()))
-}
+var (
+	defaultGenerator atomic.Pointer[Generator]
+	defaultOnce      sync.Once
+)
 
 // Default returns the default [Generator].
-func Default() *Generator { return defaultGenerator.Load() }
+//
+// It is created on first use, unless [SetDefault] was called before.
+func Default() *Generator {
+	if g := defaultGenerator.Load(); g != nil {
+		return g
+	}
+	defaultOnce.Do(func() {
+		defaultGenerator.CompareAndSwap(nil, New(newDefaultHandler()))
+	})
+	return defaultGenerator.Load()
+}
 
 // SetDefault sets the default [Generator].
 func SetDefault(g *Generator) { defaultGenerator.Store(g) }
@@ -56,15 +67,79 @@ func It(raw string) string {
 	return Default().It(raw)
 }
 
+// Merge returns the merged classes without generating a short class name.
+//
+// Multiple class strings are joined with a space before merging, so later
+// classes win conflicts with earlier ones.
+func Merge(classes ...string) string {
+	return Default().Merge(classes...)
+}
+
+// Join returns a short unique CSS class name from the merged parts, like
+// [It] with the parts joined by a space.
+//
+// Empty parts and stray whitespace are skipped, and later parts win
+// conflicts with earlier ones, so that a component can take classes that
+// override its own.
+//
+// Example: Join("px-4 py-2 bg-blue-500", props.Class)
+func Join(parts ...string) string {
+	return Default().Join(parts...)
+}
+
+// ItE is like [It] but returns an error for malformed classes instead of
+// passing them through.
+func ItE(raw string) (string, error) {
+	return Default().ItE(raw)
+}
+
+// MergeE is like [Merge] but returns an error for malformed classes instead
+// of passing them through.
+func MergeE(classes ...string) (string, error) {
+	return Default().MergeE(classes...)
+}
+
+// ParseError is returned for a class that can not be parsed, like a bare
+// variant -> hover: or unbalanced brackets -> w-[10px.
+type ParseError struct {
+	// Class is the malformed class.
+	Class string
+	// Reason describes why the class is malformed.
+	Reason string
+}
+
+// Error returns the malformed class and the reason.
+func (e *ParseError) Error() string {
+	return "malformed class " + strconv.Quote(e.Class) + ": " + e.Reason
+}
+
 // Generator generates all the code needed to use Twerge statically.
 //
 // At runtime, it uses the statically defined code, if configured, to
 // map the class names to the generated class names.
-type Generator struct{ Handler Handler }
+type Generator struct {
+	Handler Handler
+
+	// issueKinds are the kinds of issues that fail [CodeGen], nil when
+	// validation is disabled
+	issueKinds []IssueKind
+	// optimizations rewrite the merged classes, see [WithShorthands]
+	optimizations []optimization
+	// sorted stores the merged classes in canonical order, see
+	// [WithSortedClasses]
+	sorted bool
+}
+
+// Option configures a [Generator].
+type Option func(*Generator)
 
 // New creates a new Generator with the given non-nil Handler.
-func New(h Handler) *Generator {
-	return &Generator{Handler: h}
+func New(h Handler, opts ...Option) *Generator {
+	g := &Generator{Handler: h}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
 }
 
 // Handler is the interface that needs to be implemented to customize the
@@ -75,9 +150,10 @@ type Handler interface {
 	SetCache(map[string]CacheValue)
 }
 
-// Cache returns the cache of the [Generator].
-func (Generator) Cache() map[string]CacheValue {
-	return defaultGenerator.Load().Handler.Cache()
+// Cache returns the cache of the handler of the [Generator], the classes
+// written by [CodeGen].
+func (g *Generator) Cache() map[string]CacheValue {
+	return g.Handler.Cache()
 }
 
 // Cache returns the cache of the [Generator].
@@ -98,18 +174,140 @@ func (g *Generator) It(classes string) string {
 	return g.Handler.It(classes)
 }
 
+// Merge returns the merged classes without generating a short class name.
+//
+// Unlike [Generator.It], the result is not registered in the cache, so it
+// is not picked up by [CodeGen].
+func (g *Generator) Merge(classes ...string) string {
+	return g.optimize(g.merge(strings.Join(classes, " ")))
+}
+
+// Join returns a short unique CSS class name from the merged parts, like
+// [Generator.It] with the parts joined by a space.
+//
+// The parts are joined with single spaces, so that the same classes are
+// registered once whatever the whitespace around them. It returns an
+// empty string if every part is empty.
+func (g *Generator) Join(parts ...string) string {
+	var fields []string
+	for _, part := range parts {
+		fields = append(fields, strings.Fields(part)...)
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return g.Handler.It(strings.Join(fields, " "))
+}
+
+// ItE is like [Generator.It] but returns an error for malformed classes
+// instead of passing them through.
+//
+// The error joins a [*ParseError] for every malformed class.
+func (g *Generator) ItE(classes string) (string, error) {
+	if err := g.check(classes); err != nil {
+		return "", err
+	}
+	return g.Handler.It(classes), nil
+}
+
+// MergeE is like [Generator.Merge] but returns an error for malformed
+// classes instead of passing them through.
+//
+// The error joins a [*ParseError] for every malformed class.
+func (g *Generator) MergeE(classes ...string) (string, error) {
+	joined := strings.Join(classes, " ")
+	if err := g.check(joined); err != nil {
+		return "", err
+	}
+	return g.optimize(g.merge(joined)), nil
+}
+
+// merger is implemented by handlers that can merge classes without
+// generating a short class name.
+type merger interface {
+	Merge(string) string
+}
+
+// merge returns the merged classes of the handler, or of mergeHandler for
+// handlers that can not merge.
+func (g *Generator) merge(classes string) string {
+	if m, ok := g.Handler.(merger); ok {
+		return m.Merge(classes)
+	}
+	return g.mergeHandler().Merge(classes)
+}
+
+// check returns the errors of the malformed classes.
+func (g *Generator) check(classes string) error {
+	_, err := g.mergeHandler().parseAll(classes)
+	return err
+}
+
+// mergeHandler returns the handler of the generator, or a handler with the
+// default configuration for handlers without a config.
+//
+// It backs the methods of the [Generator] that need a config, like
+// [Generator.Explain] and [Generator.Validate].
+func (g *Generator) mergeHandler() *defaultHandler {
+	if h, ok := g.Handler.(*defaultHandler); ok {
+		return h
+	}
+	return fallbackHandler()
+}
+
+// fallbackHandler is the handler of the generators whose handler has no
+// config, it is only used to read its config and never caches classes.
+var fallbackHandler = sync.OnceValue(newDefaultHandler)
+
+// NewHandler creates a new [Handler] that merges classes using the given
+// [Config].
+//
+// If cfg is nil, the default configuration is used. The configuration is
+// compiled into a lookup table on first use and must not be modified
+// afterwards.
+//
+// A config built on the v3 class groups whose Version is V4 is merged
+// with a copy that has the class groups of v4.
+func NewHandler(cfg *Config) Handler {
+	h := newDefaultHandler()
+	h.config = baseConfig(cfg)
+	return h
+}
+
+// baseConfig returns the config that cfg stands for: the default config if
+// it is nil, with the class groups of v4 if its Version is V4.
+func baseConfig(cfg *Config) *Config {
+	if cfg == nil {
+		return defaultConfig()
+	}
+	if cfg.Version == V4 && !cfg.v4Groups {
+		return withV4Groups(cfg)
+	}
+	return cfg
+}
+
 func newDefaultHandler() *defaultHandler {
 	return &defaultHandler{
 		entries: make(map[string]CacheValue),
-		config:  defaultConfig,
+		config:  defaultConfig(),
 	}
 
 }
 
 type defaultHandler struct {
-	config  *config
+	config  *Config
 	entries map[string]CacheValue
 	mu      sync.RWMutex
+
+	// compiled config, see table
+	compiled  *classTable
+	tableOnce sync.Once
+}
+
+// table returns the compiled config of the handler.
+func (g *defaultHandler) table() *classTable {
+	g.tableOnce.Do(func() { g.compiled = g.config.table() })
+	return g.compiled
 }
 
 func (g *defaultHandler) It(classes string) string {
@@ -123,7 +321,7 @@ func (g *defaultHandler) It(classes string) string {
 
 	// Write Safe Lock
 	g.mu.Lock()
-	className := "tw-" + strconv.Itoa(len(g.entries))
+	className := g.config.generatedPrefix() + strconv.Itoa(len(g.entries))
 	g.entries[classes] = CacheValue{
 		Generated: className,
 		Merged:    g.merge(classes),
@@ -133,160 +331,372 @@ func (g *defaultHandler) It(classes string) string {
 	return className
 }
 
+// Merge returns the merged classes without generating a short class name.
+func (g *defaultHandler) Merge(classes string) string {
+	return g.merge(classes)
+}
+
+// merge resolves the conflicts between the given classes.
+//
+// It runs for every uncached class string, so it reuses the scratch space
+// of a pooled [mergeState] and returns the input itself when nothing
+// changes.
 func (g *defaultHandler) merge(classes string) string {
-	var (
-		uniques = make(map[string]string)
-		merged  string
-	)
-	classesSeq := strings.SplitSeq(strings.TrimSpace(classes), " ")
-
-	for class := range classesSeq {
-		var (
-			modifiers     []string
-			modifierStart int
-			bracketDepth  int
-
-			isTwClass bool
-			groupID   string
-		)
-		separator := g.config.ModifierSeparator
-		// used for examples like 'bg-red-500/50' (50% opacity)
-		postFixMod := -1
-
-		for i := range len(class) {
-			char := rune(class[i])
-
-			if char == '[' {
-				bracketDepth++
-				continue
-			}
-			if char == ']' {
-				bracketDepth--
-				continue
-			}
+	state := mergeStatePool.Get().(*mergeState)
+	defer state.release()
 
-			if bracketDepth == 0 {
-				if char == separator {
-					modifiers = append(
-						modifiers,
-						class[modifierStart:i],
-					)
-					modifierStart = i + 1
-					continue
-				}
+	state.decisions, state.modifiers, _ = g.parseInto(
+		state.decisions,
+		state.modifiers,
+		classes,
+	)
+	g.resolveDecisions(state)
 
-				if char == g.config.PostfixModifier {
-					postFixMod = i
-				}
-			}
+	for _, decision := range state.decisions {
+		if !decision.Kept {
+			continue
+		}
+		if len(state.buf) > 0 {
+			state.buf = append(state.buf, ' ')
 		}
+		state.buf = append(state.buf, decision.Class...)
+	}
+	if string(state.buf) == classes {
+		return classes
+	}
+	return string(state.buf)
+}
 
-		base := class[modifierStart:]
-		hasImportant := base[0] == byte(g.config.ImportantModifier)
+// mergeState is the scratch space of a merge.
+type mergeState struct {
+	decisions []Decision
+	modifiers []string
+	// class group and scope of every decision
+	groups []int32
+	scopes []int32
+	// modifiers of every scope, their claimed class groups are the
+	// bitsets of claimed
+	scopeKeys []scopeKey
+	claimed   []uint64
+	// class groups of arbitrary properties, numbered after the groups of
+	// the config
+	arbitrary []string
+	seenRaw   map[string]int
+	buf       []byte
+}
 
-		if hasImportant {
-			base = base[1:]
-		}
+// scopeKey identifies the modifiers of a class, only classes with the
+// same modifiers conflict -> hover:!p-4 and hover:!px-2.
+type scopeKey struct {
+	modifiers string
+	important bool
+}
 
-		// if there is modifier & maybePostfix which causes
-		// postfixModPos to be beyond size of baseClass
-		if postFixMod != -1 && postFixMod > modifierStart {
-			postFixMod -= modifierStart
-		} else {
-			postFixMod = -1
-		}
+// maxPooledClasses is the number of classes above which a mergeState is
+// not returned to the pool, so that one huge class string does not pin
+// its memory.
+const maxPooledClasses = 256
+
+var mergeStatePool = sync.Pool{
+	New: func() any { return newMergeState() },
+}
 
-		// there is a postfix modifier -> text-lg/8
-		if postFixMod != -1 {
-			base = base[:postFixMod]
+func newMergeState() *mergeState {
+	return &mergeState{seenRaw: make(map[string]int)}
+}
+
+// release resets the state and returns it to the pool.
+func (s *mergeState) release() {
+	if cap(s.decisions) > maxPooledClasses {
+		return
+	}
+	// drop the references to the merged strings
+	clear(s.decisions)
+	clear(s.modifiers)
+	clear(s.scopeKeys)
+	clear(s.arbitrary)
+	clear(s.seenRaw)
+	s.decisions = s.decisions[:0]
+	s.modifiers = s.modifiers[:0]
+	s.groups = s.groups[:0]
+	s.scopes = s.scopes[:0]
+	s.scopeKeys = s.scopeKeys[:0]
+	s.claimed = s.claimed[:0]
+	s.arbitrary = s.arbitrary[:0]
+	s.buf = s.buf[:0]
+	mergeStatePool.Put(s)
+}
+
+// parseAll parses every whitespace separated class, the errors of the
+// malformed classes are joined.
+func (g *defaultHandler) parseAll(classes string) ([]Decision, error) {
+	decisions, _, err := g.parseInto(nil, nil, classes)
+	return decisions, err
+}
+
+// parseInto is like parseAll but appends the decisions and their modifiers
+// to the given slices, so that their memory can be reused.
+func (g *defaultHandler) parseInto(
+	decisions []Decision,
+	modifiers []string,
+	classes string,
+) ([]Decision, []string, error) {
+	var errs []error
+	for token := range strings.FieldsSeq(classes) {
+		decision, err := g.parse(token, modifiers[len(modifiers):])
+		if err != nil {
+			errs = append(errs, err)
 		}
-		isTwClass, groupID = g.getClassGroupID(base)
-		if !isTwClass {
-			merged += class + " "
+		// the modifiers were appended after the previous ones, unless parse
+		// had to grow the slice
+		modifiers = append(modifiers, decision.Modifiers...)
+		decisions = append(decisions, decision)
+	}
+	return decisions, modifiers, errors.Join(errs...)
+}
+
+// resolve decides for every class whether it is kept or overridden.
+func (g *defaultHandler) resolve(classes string) []Decision {
+	state := newMergeState()
+	state.decisions, _ = g.parseAll(classes)
+	g.resolveDecisions(state)
+	return state.decisions
+}
+
+// resolveDecisions sets Kept and OverriddenBy of the parsed classes of the
+// state.
+//
+// Classes are walked from last to first so that the last class of a group
+// wins, while the surviving classes keep their original relative order.
+func (g *defaultHandler) resolveDecisions(state *mergeState) {
+	table := g.table()
+	decisions := state.decisions
+	for _, decision := range decisions {
+		state.groups = append(state.groups, state.group(table, decision))
+		state.scopes = append(state.scopes, -1)
+	}
+	words := (len(table.groupIDs) + len(state.arbitrary) + 63) / 64
+
+	for idx := len(decisions) - 1; idx >= 0; idx-- {
+		decision := &decisions[idx]
+		if !decision.IsTailwind {
+			// non-tailwind classes never conflict, only exact duplicates
+			// are dropped
+			if winner, ok := state.seenRaw[decision.Class]; ok {
+				decision.OverriddenBy = winner
+				continue
+			}
+			state.seenRaw[decision.Class] = idx
+			decision.Kept = true
 			continue
 		}
-		// sort as hover:focus:bg-red-500 == focus:hover:bg-red-500
-		modifiers = sortModifiers(modifiers)
-		if hasImportant {
-			modifiers = append(modifiers, "!")
-		}
-		uniques[groupID+strings.Join(
-			modifiers,
-			string(g.config.ModifierSeparator),
-		)] = class
-
-		conflicts := g.config.ConflictingClassGroups[groupID]
-		if conflicts == nil {
+		scope := state.scope(scopeKey{
+			// sorted as hover:focus:bg-red-500 == focus:hover:bg-red-500
+			modifiers: g.config.modifierID(decision.Class, decision.Modifiers),
+			important: decision.Important,
+		}, words)
+		state.scopes[idx] = scope
+		claimed := state.claimed[int(scope)*words : int(scope+1)*words]
+		group := state.groups[idx]
+
+		// a later class of the same group (or a conflicting group)
+		// already won
+		if hasBit(claimed, group) {
+			decision.OverriddenBy = state.winner(table, idx)
 			continue
 		}
-		for _, conflict := range conflicts {
+		setBit(claimed, group)
+		if int(group) < len(table.groupIDs) {
 			// erase the conflicts with the same modifiers
-			uniques[conflict+strings.Join(
-				modifiers,
-				string(g.config.ModifierSeparator),
-			)] = ""
+			orBits(claimed, table.bitset(table.conflicts, group))
+			if decision.Postfix != "" {
+				// the postfix sets more than the class group itself
+				// -> text-lg/7 also sets the line-height
+				orBits(claimed, table.bitset(table.modifierConflicts, group))
+			}
+		} else if property, ok := arbitraryProperty(decision.GroupID); ok {
+			// erase the class groups setting the same css properties
+			// -> [display:flex] overrides block
+			orBits(claimed, table.overriddenBy[property])
+		}
+		// arbitrary properties conflict through their css properties
+		// -> p-4 overrides [padding-left:4px]
+		for i := range state.arbitrary {
+			other := int32(len(table.groupIDs) + i)
+			if table.claims(group, false, other, state.arbitrary) {
+				setBit(claimed, other)
+			}
 		}
+		decision.Kept = true
 	}
+}
 
-	for _, unique := range uniques {
-		if unique == "" {
-			continue
+// group returns the class group of a decision, class groups of arbitrary
+// properties are numbered after the class groups of the table.
+func (s *mergeState) group(table *classTable, decision Decision) int32 {
+	if !decision.IsTailwind {
+		return noGroup
+	}
+	if group, ok := table.groups[decision.GroupID]; ok {
+		return group
+	}
+	for i, groupID := range s.arbitrary {
+		if groupID == decision.GroupID {
+			return int32(len(table.groupIDs) + i)
 		}
-		merged += unique + " "
 	}
-	return strings.TrimSpace(merged)
+	s.arbitrary = append(s.arbitrary, decision.GroupID)
+	return int32(len(table.groupIDs) + len(s.arbitrary) - 1)
 }
 
-func (g *defaultHandler) getClassGroupIDRecursive(
-	classParts []string,
-	i int,
-	configClassGroups *classPart,
-) (isTwClass bool, groupID string) {
-	if i >= len(classParts) {
-		if configClassGroups.ClassGroupID != "" {
-			return true, configClassGroups.ClassGroupID
+// scope returns the index of the scope, adding it with no claimed class
+// groups if it is new.
+func (s *mergeState) scope(key scopeKey, words int) int32 {
+	for i, scopeKey := range s.scopeKeys {
+		if scopeKey == key {
+			return int32(i)
 		}
-
-		return false, ""
 	}
+	s.scopeKeys = append(s.scopeKeys, key)
+	s.claimed = slices.Grow(s.claimed, words)
+	s.claimed = s.claimed[:len(s.claimed)+words]
+	clear(s.claimed[len(s.claimed)-words:])
+	return int32(len(s.scopeKeys) - 1)
+}
 
-	if configClassGroups.NextPart != nil {
-		nextClassMap := configClassGroups.NextPart[classParts[i]]
-		isTw, id := g.getClassGroupIDRecursive(
-			classParts,
-			i+1,
-			&nextClassMap,
-		)
-		if isTw {
-			return isTw, id
+// winner returns the index of the kept class that claimed the class group
+// of the class at idx, which is the last one in the same scope.
+func (s *mergeState) winner(table *classTable, idx int) int {
+	for j := len(s.decisions) - 1; j > idx; j-- {
+		decision := s.decisions[j]
+		if decision.Kept && s.scopes[j] == s.scopes[idx] &&
+			table.claims(s.groups[j], decision.Postfix != "", s.groups[idx], s.arbitrary) {
+			return j
 		}
 	}
+	return -1
+}
+
+// parse splits a class into its modifiers, important flag, postfix and
+// base class, and resolves its class group.
+//
+// The modifiers are appended to the given slice, which may be nil.
+//
+// Malformed classes are returned as non-tailwind classes along with a
+// [*ParseError].
+func (g *defaultHandler) parse(class string, modifiers []string) (Decision, error) {
+	var (
+		modifierStart int
+		bracketDepth  int
+		unbalanced    bool
+
+		isTwClass bool
+		groupID   string
+	)
+	decision := Decision{Class: class, OverriddenBy: -1}
+	separator := g.config.ModifierSeparator
+	// used for examples like 'bg-red-500/50' (50% opacity)
+	postFixMod := -1
 
-	if len(configClassGroups.Validators) > 0 {
-		remainingClass := strings.Join(
-			classParts[i:],
-			string(g.config.ClassSeparator),
-		)
+	// the v4 prefix is written like a variant -> tw:hover:p-4
+	token, hasPrefix := g.config.trimVariantPrefix(class)
 
-		for _, validator := range configClassGroups.Validators {
-			if validator.Fn(remainingClass) {
-				return true, validator.ClassGroupID
+	for i := range len(token) {
+		char := rune(token[i])
+
+		// arbitrary values -> [10px] and css variables -> (--brand)
+		if char == '[' || char == '(' {
+			bracketDepth++
+			continue
+		}
+		if char == ']' || char == ')' {
+			bracketDepth--
+			unbalanced = unbalanced || bracketDepth < 0
+			continue
+		}
+
+		if bracketDepth == 0 {
+			if char == separator {
+				if i == modifierStart {
+					return decision, &ParseError{Class: class, Reason: "empty variant"}
+				}
+				modifiers = append(
+					modifiers,
+					token[modifierStart:i],
+				)
+				modifierStart = i + 1
+				continue
+			}
+
+			if char == g.config.PostfixModifier {
+				postFixMod = i
 			}
 		}
+	}
+	if unbalanced || bracketDepth != 0 {
+		return decision, &ParseError{Class: class, Reason: "unbalanced brackets or parentheses"}
+	}
+	if len(modifiers) > 0 {
+		decision.Modifiers = modifiers[:len(modifiers):len(modifiers)]
+	}
 
+	base := token[modifierStart:]
+	postfixLen := 0
+	if postFixMod != -1 {
+		postfixLen = len(token) - postFixMod
 	}
-	return false, ""
+	important := byte(g.config.ImportantModifier)
+	hasImportant := base != "" && base[0] == important
+	if hasImportant {
+		base = base[1:]
+	} else if base != "" && base[len(base)-1] == important {
+		// v4 important modifier at the end -> bg-red-500!
+		hasImportant = true
+		base = base[:len(base)-1]
+		postfixLen--
+	}
+	if base == "" {
+		return decision, &ParseError{Class: class, Reason: "empty class"}
+	}
+	decision.Important = hasImportant
+
+	// the v3 prefix is written before the utility -> hover:tw-p-4
+	if !g.config.isVariantPrefix() {
+		base, hasPrefix = g.config.trimClassPrefix(base)
+	}
+
+	// there is a postfix modifier -> text-lg/8
+	//
+	// if there is modifier & maybePostfix which causes
+	// postfixModPos to be beyond size of baseClass
+	hasPostfix := postFixMod != -1 && postFixMod > modifierStart &&
+		postfixLen < len(base)
+	if hasPrefix && hasPostfix {
+		isTwClass, groupID = g.getClassGroupID(base[:len(base)-postfixLen])
+		// the postfix might be part of the class itself
+		hasPostfix = isTwClass
+	}
+	if hasPrefix && !isTwClass {
+		isTwClass, groupID = g.getClassGroupID(base)
+	}
+
+	decision.IsTailwind = isTwClass
+	decision.GroupID = groupID
+	// classes without the prefix are not looked up -> w-1/2 with tw-
+	if hasPostfix && isTwClass {
+		decision.Postfix = base[len(base)-postfixLen+1:]
+	}
+	return decision, nil
 }
 
 func (g *defaultHandler) getGroupIDForArbitraryProperty(class string) (bool, string) {
-	if arbitraryPropertyRegex.MatchString(class) {
-		name := arbitraryPropertyRegex.FindStringSubmatch(class)[1]
-		property := name[:strings.Index(name, ":")]
-
-		if property != "" {
-			// two dots here because one dot is used as prefix for class groups in plugins
-			return true, "arbitrary.." + property
-		}
+	name, ok := cutBrackets(class, '[', ']')
+	if !ok {
+		return false, ""
+	}
+	property, _, found := strings.Cut(name, ":")
+	if found && property != "" {
+		return true, arbitraryGroupPrefix + property
 	}
 
 	return false, ""
@@ -294,18 +704,9 @@ func (g *defaultHandler) getGroupIDForArbitraryProperty(class string) (bool, str
 
 // getClassGroupID returns a boolean and a string
 func (g *defaultHandler) getClassGroupID(baseClass string) (bool, string) {
-	classParts := strings.Split(baseClass, string(g.config.ClassSeparator))
-	// remove first element if empty for things like -px-4
-	if len(classParts) > 0 && classParts[0] == "" {
-		classParts = classParts[1:]
-	}
-	isTwClass, groupID := g.getClassGroupIDRecursive(
-		classParts,
-		0,
-		&g.config.ClassGroups,
-	)
-	if isTwClass {
-		return isTwClass, groupID
+	table := g.table()
+	if group := table.lookup(baseClass, g.config.ClassSeparator); group != noGroup {
+		return true, table.groupIDs[group]
 	}
 
 	return g.getGroupIDForArbitraryProperty(baseClass)
@@ -313,18 +714,20 @@ func (g *defaultHandler) getClassGroupID(baseClass string) (bool, string) {
 
 // sortModifiers Sorts modifiers according to following schema:
 // - Predefined modifiers are sorted alphabetically
-// - When an arbitrary variant appears, it must be preserved which modifiers are before and after it
-func sortModifiers(modifiers []string) []string {
+// - When an arbitrary variant or an order-sensitive modifier appears, it must
+// be preserved which modifiers are before and after it
+func (c *Config) sortModifiers(modifiers []string) []string {
 	if len(modifiers) < 2 {
 		return modifiers
 	}
 
 	unsortedModifiers := []string{}
-	sorted := make([]string, len(modifiers))
+	sorted := make([]string, 0, len(modifiers))
 
 	for _, modifier := range modifiers {
-		isArbitraryVariant := modifier[0] == '['
-		if isArbitraryVariant {
+		isPositionSensitive := modifier[0] == '[' ||
+			c.isOrderSensitive(modifier)
+		if isPositionSensitive {
 			slices.Sort(unsortedModifiers)
 			sorted = append(sorted, unsortedModifiers...)
 			sorted = append(sorted, modifier)
@@ -339,3 +742,65 @@ func sortModifiers(modifiers []string) []string {
 
 	return sorted
 }
+
+// isOrderSensitive returns true if reordering the modifier changes the
+// selector -> hover:before: is not the same as before:hover:
+func (c *Config) isOrderSensitive(modifier string) bool {
+	return matchModifier(c.OrderSensitiveModifiers, modifier)
+}
+
+// matchModifier returns true if the modifier is one of the patterns, a
+// pattern with a trailing -* matches by prefix -> has-* matches has-checked.
+func matchModifier(patterns []string, modifier string) bool {
+	for _, pattern := range patterns {
+		if modifier == pattern {
+			return true
+		}
+		// keep the trailing - of the -* suffix -> has-
+		prefix, isPrefix := strings.CutSuffix(pattern, "*")
+		if isPrefix && strings.HasSuffix(prefix, "-") &&
+			strings.HasPrefix(modifier, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// modifierID returns the modifiers of a class in the order used to find
+// conflicts.
+//
+// Modifiers that are already in that order are returned as they are
+// written in the class instead of being joined again.
+func (c *Config) modifierID(class string, modifiers []string) string {
+	if len(modifiers) == 0 {
+		return ""
+	}
+	if !c.isModifierOrder(modifiers) {
+		return strings.Join(c.sortModifiers(modifiers), string(c.ModifierSeparator))
+	}
+	// the modifiers are the start of the class after the v4 prefix, one
+	// separator apart
+	token, _ := c.trimVariantPrefix(class)
+	n := (len(modifiers) - 1) * utf8.RuneLen(c.ModifierSeparator)
+	for _, modifier := range modifiers {
+		n += len(modifier)
+	}
+	return token[:n]
+}
+
+// isModifierOrder returns true if sortModifiers would keep the order of the
+// modifiers.
+func (c *Config) isModifierOrder(modifiers []string) bool {
+	prev := ""
+	for _, modifier := range modifiers {
+		if modifier[0] == '[' || c.isOrderSensitive(modifier) {
+			prev = ""
+			continue
+		}
+		if modifier < prev {
+			return false
+		}
+		prev = modifier
+	}
+	return true
+}
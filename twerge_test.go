@@ -1,7 +1,8 @@
 package twerge
 
 import (
-	"sort"
+	"errors"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -333,6 +334,18 @@ func TestTailwindMerge(t *testing.T) {
 		}, {
 			in:  "w-full w-1/2",
 			out: "w-1/2",
+		}, {
+			in:  "leading-5 text-lg/7",
+			out: "text-lg/7",
+		}, {
+			in:  "!text-lg/7 !text-lg/8",
+			out: "!text-lg/8",
+		}, {
+			in:  "bg-red-500/50 bg-red-500/[0.3]",
+			out: "bg-red-500/[0.3]",
+		}, {
+			in:  "hover:bg-red-500/50 bg-red-500/[0.3]",
+			out: "hover:bg-red-500/50 bg-red-500/[0.3]",
 		},
 		// handles negative value conflicts correctly
 		{
@@ -534,13 +547,87 @@ func TestTailwindMerge(t *testing.T) {
 			in:  "group-has-[[data-sidebar=menu-action]]/menu-item:pr-8 group-has-[[data-sidebar=menu-action]]/menu-item:pr-6",
 			out: "group-has-[[data-sidebar=menu-action]]/menu-item:pr-6",
 		},
+		// keeps the original relative order of the classes
+		{
+			in:  "p-2 text-red-500 flex p-4",
+			out: "text-red-500 flex p-4",
+		}, {
+			in:  "bg-gray-50 text-gray-900 flex flex-col min-h-screen",
+			out: "bg-gray-50 text-gray-900 flex flex-col min-h-screen",
+		},
+		// keeps the order of order-sensitive modifiers
+		{
+			in:  "before:hover:p-2 hover:before:p-4",
+			out: "before:hover:p-2 hover:before:p-4",
+		}, {
+			in:  "hover:before:focus:p-2 hover:before:focus:p-4",
+			out: "hover:before:focus:p-4",
+		}, {
+			in:  "*:hover:p-2 hover:*:p-4",
+			out: "*:hover:p-2 hover:*:p-4",
+		}, {
+			in:  "has-checked:hover:p-2 hover:has-checked:p-4",
+			out: "has-checked:hover:p-2 hover:has-checked:p-4",
+		}, {
+			in:  "not-first:focus:p-2 focus:not-first:p-4",
+			out: "not-first:focus:p-2 focus:not-first:p-4",
+		},
+		// supports Tailwind CSS v4 syntax
+		{
+			in:  "bg-red-500! bg-blue-500!",
+			out: "bg-blue-500!",
+		}, {
+			in:  "bg-red-500! !bg-blue-500",
+			out: "!bg-blue-500",
+		}, {
+			in:  "bg-red-500! bg-blue-500",
+			out: "bg-red-500! bg-blue-500",
+		}, {
+			in:  "bg-red-500/50! bg-red-500/[0.3]!",
+			out: "bg-red-500/[0.3]!",
+		}, {
+			in:  "bg-(--brand) bg-red-500",
+			out: "bg-red-500",
+		}, {
+			in:  "hover:bg-(--a) hover:bg-(--b)",
+			out: "hover:bg-(--b)",
+		}, {
+			in:  "w-(length:--size) w-4",
+			out: "w-4",
+		}, {
+			in:  "text-(length:--size) text-red-500",
+			out: "text-(length:--size) text-red-500",
+		}, {
+			in:  "text-(length:--size) text-lg",
+			out: "text-lg",
+		}, {
+			in:  "@md:p-4 @md:p-2 md:p-1",
+			out: "@md:p-2 md:p-1",
+		}, {
+			in:  "@max-lg:p-4 @max-lg:p-2",
+			out: "@max-lg:p-2",
+		}, {
+			in:  "group-hover/item:p-4 group-hover/item:p-2",
+			out: "group-hover/item:p-2",
+		}, {
+			in:  "group-hover/item:p-4 group-hover/other:p-2",
+			out: "group-hover/item:p-4 group-hover/other:p-2",
+		}, {
+			in:  "peer-checked/draft:block peer-checked/draft:hidden",
+			out: "peer-checked/draft:hidden",
+		},
+		// drops exact duplicates of non-tailwind classes
+		{
+			in:  "card p-2 card-body card",
+			out: "p-2 card-body card",
+		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.in, func(t *testing.T) {
 			h := newDefaultHandler()
 			got := h.merge(tc.in)
-			if areStringsEqual(got, tc.out) == false {
+			if got != tc.out {
 				t.Errorf("twMerge failed -> |\n in: %s \nout: %s \nwanted: %s", tc.in, got, tc.out)
 			} /* else {
 				// t.Log("twMerge passed -> | in: ", tc.in, " | out: ", got, " | expected: ", tc.out)
@@ -549,15 +636,275 @@ func TestTailwindMerge(t *testing.T) {
 	}
 }
 
-func areStringsEqual(s1, s2 string) bool {
-	// Split each string into individual parts
-	parts1 := strings.Split(s1, " ")
-	parts2 := strings.Split(s2, " ")
+func TestMerge(t *testing.T) {
+	tt := []struct {
+		in  []string
+		out string
+	}{
+		{
+			in:  []string{"px-2 py-1 bg-red hover:bg-dark-red", "p-3 bg-[#B91C1C]"},
+			out: "hover:bg-dark-red p-3 bg-[#B91C1C]",
+		}, {
+			in:  []string{"text-red-500", "", "text-blue-500"},
+			out: "text-blue-500",
+		}, {
+			in:  []string{""},
+			out: "",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(strings.Join(tc.in, ","), func(t *testing.T) {
+			g := New(newDefaultHandler())
+			got := g.Merge(tc.in...)
+			if got != tc.out {
+				t.Errorf("Merge failed -> |\n in: %q \nout: %s \nwanted: %s", tc.in, got, tc.out)
+			}
+			if len(g.Handler.Cache()) != 0 {
+				t.Errorf("Merge should not register classes in the cache")
+			}
+		})
+	}
+}
+
+// itHandler is a Handler that can not merge without generating a name.
+type itHandler struct{ Handler }
+
+func TestMergeWithoutMerger(t *testing.T) {
+	g := New(itHandler{newDefaultHandler()})
+	if got := g.Merge("p-2", "p-4"); got != "p-4" {
+		t.Errorf("Merge() = %s, wanted p-4 from the default configuration", got)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	g := New(newDefaultHandler())
+	// the parts are registered as one class string, later parts win
+	class := g.Join("  px-4 py-2 bg-blue-500 ", "", "\tbg-red-500\n")
+	want := CacheValue{Generated: class, Merged: "px-4 py-2 bg-red-500"}
+	if got, ok := g.Cache()["px-4 py-2 bg-blue-500 bg-red-500"]; !ok || got != want {
+		t.Errorf("Cache() = %v, wanted the joined parts to be %v", g.Cache(), want)
+	}
+	if got := g.Join("px-4 py-2", "bg-blue-500 bg-red-500"); got != class {
+		t.Errorf("Join() = %s, wanted %s for the same classes", got, class)
+	}
+	if got := g.It("px-4 py-2 bg-blue-500 bg-red-500"); got != class {
+		t.Errorf("It() = %s, wanted the class of Join %s", got, class)
+	}
+	if got := g.Join("", "  "); got != "" || len(g.Cache()) != 1 {
+		t.Errorf("Join of empty parts = %q, wanted an empty string and no entry", got)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	tt := []struct {
+		prefix string
+		in     string
+		out    string
+	}{
+		{
+			prefix: "tw-",
+			in:     "tw-p-2 tw-p-4",
+			out:    "tw-p-4",
+		}, {
+			prefix: "tw-",
+			in:     "hover:tw-block hover:tw-inline",
+			out:    "hover:tw-inline",
+		}, {
+			prefix: "tw-",
+			in:     "!tw-font-medium !tw-font-bold",
+			out:    "!tw-font-bold",
+		}, {
+			prefix: "tw-",
+			in:     "-tw-m-2 tw-m-4",
+			out:    "tw-m-4",
+		}, {
+			prefix: "tw-",
+			in:     "tw-text-lg/7 tw-text-lg/8",
+			out:    "tw-text-lg/8",
+		}, {
+			prefix: "tw-",
+			in:     "p-2 tw-p-4 p-3",
+			out:    "p-2 tw-p-4 p-3",
+		}, {
+			prefix: "tw:",
+			in:     "tw:p-2 tw:p-4",
+			out:    "tw:p-4",
+		}, {
+			prefix: "tw:",
+			in:     "tw:hover:block tw:hover:inline tw:focus:block",
+			out:    "tw:hover:inline tw:focus:block",
+		}, {
+			prefix: "tw:",
+			in:     "tw:!font-medium tw:!font-bold",
+			out:    "tw:!font-bold",
+		}, {
+			prefix: "tw:",
+			in:     "p-2 tw:p-4 p-3",
+			out:    "p-2 tw:p-4 p-3",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.prefix+" "+tc.in, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Prefix = tc.prefix
+			got := New(NewHandler(cfg)).Merge(tc.in)
+			if got != tc.out {
+				t.Errorf("merge failed -> |\n in: %s \nout: %s \nwanted: %s", tc.in, got, tc.out)
+			}
+		})
+	}
+}
+
+func TestGeneratedPrefix(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Prefix = "tw-"
+	if got := NewHandler(cfg).It("p-2"); got != "twerge-0" {
+		t.Errorf("generated name should not collide with the prefix: got %s", got)
+	}
+	if got := NewHandler(nil).It("p-2"); got != "tw-0" {
+		t.Errorf("generated name: got %s", got)
+	}
+}
+
+func TestConflictingClassGroupModifiers(t *testing.T) {
+	// without the plain font-size conflict, only a postfix overrides leading
+	cfg := MergeConfig(DefaultConfig(), ConfigExtension{
+		Override: ConfigGroups{
+			ConflictingClassGroups: ConflictingClassGroups{"font-size": {}},
+		},
+	})
+	testMerges(t, New(NewHandler(cfg)), []mergeTest{
+		{
+			in:  "leading-5 text-lg",
+			out: "leading-5 text-lg",
+		}, {
+			in:  "leading-5 text-lg/7",
+			out: "text-lg/7",
+		}, {
+			in:  "text-lg/7 leading-5",
+			out: "text-lg/7 leading-5",
+		}, {
+			in:  "hover:leading-5 text-lg/7",
+			out: "hover:leading-5 text-lg/7",
+		},
+	})
+}
+
+func TestSortModifiers(t *testing.T) {
+	tt := []struct {
+		in  []string
+		out []string
+	}{
+		{
+			in:  []string{"hover", "focus"},
+			out: []string{"focus", "hover"},
+		}, {
+			in:  []string{"hover", "focus", "before", "dark", "active"},
+			out: []string{"focus", "hover", "before", "active", "dark"},
+		}, {
+			in:  []string{"md", "[&>*]", "hover", "dark"},
+			out: []string{"md", "[&>*]", "dark", "hover"},
+		}, {
+			in:  []string{"focus", "has-[:checked]", "dark"},
+			out: []string{"focus", "has-[:checked]", "dark"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(strings.Join(tc.in, ":"), func(t *testing.T) {
+			got := defaultConfig().sortModifiers(tc.in)
+			if !slices.Equal(got, tc.out) {
+				t.Errorf("sortModifiers() = %q, wanted %q", got, tc.out)
+			}
+		})
+	}
+}
+
+func TestMergeMalformed(t *testing.T) {
+	tt := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "p-2  p-4",
+			out: "p-4",
+		}, {
+			in:  "p-2\tm-2\n\t\tp-4\r\n",
+			out: "m-2 p-4",
+		}, {
+			in:  "hover: p-2",
+			out: "hover: p-2",
+		}, {
+			in:  "! hover:! hover::p-2 p-2",
+			out: "! hover:! hover::p-2 p-2",
+		}, {
+			in:  "w-[10px w-2 [foo] w-10px]",
+			out: "w-[10px w-2 [foo] w-10px]",
+		}, {
+			in:  "   ",
+			out: "",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.in, func(t *testing.T) {
+			got := newDefaultHandler().merge(tc.in)
+			if got != tc.out {
+				t.Errorf("merge failed -> |\n in: %q \nout: %q \nwanted: %q", tc.in, got, tc.out)
+			}
+		})
+	}
+}
+
+func TestMergeE(t *testing.T) {
+	g := New(newDefaultHandler())
+	got, err := g.MergeE("p-2\n p-4")
+	if err != nil || got != "p-4" {
+		t.Errorf("MergeE() = %q, %v", got, err)
+	}
 
-	// Sort the parts
-	sort.Strings(parts1)
-	sort.Strings(parts2)
+	_, err = g.MergeE("hover: p-2 w-[10px")
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Class != "hover:" {
+		t.Fatalf("MergeE() error = %v, wanted a *ParseError", err)
+	}
+	if !strings.Contains(err.Error(), "w-[10px") {
+		t.Errorf("MergeE() error should report every malformed class: %v", err)
+	}
+
+	if _, err := g.ItE("hover:"); err == nil {
+		t.Error("ItE() should return an error")
+	}
+	if len(g.Handler.Cache()) != 0 {
+		t.Error("ItE() should not register malformed classes")
+	}
+	if _, err := New(NewDebugHandler()).ItE("!"); err == nil {
+		t.Error("ItE() should check handlers without a config")
+	}
+}
+
+// mergeTest is a case of testMerges.
+type mergeTest struct {
+	in  string
+	out string
+}
+
+// testMerges merges the input of every case with g and checks the output.
+func testMerges(t *testing.T, g *Generator, tt []mergeTest) {
+	t.Helper()
+	for _, tc := range tt {
+		t.Run(tc.in, func(t *testing.T) {
+			if got := g.Merge(tc.in); got != tc.out {
+				t.Errorf("Merge(%q) = %q, wanted %q", tc.in, got, tc.out)
+			}
+		})
+	}
+}
 
-	// Compare the sorted parts
-	return strings.Join(parts1, " ") == strings.Join(parts2, " ")
+// testGroupOf checks the class group of every class with g.
+func testGroupOf(t *testing.T, g *Generator, groups map[string]string) {
+	t.Helper()
+	for class, want := range groups {
+		if got := g.GroupOf(class); got != want {
+			t.Errorf("GroupOf(%s) = %q, wanted %q", class, got, want)
+		}
+	}
 }
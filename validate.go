@@ -0,0 +1,291 @@
+package twerge
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// IssueKind is the kind of an [Issue].
+type IssueKind int
+
+const (
+	// IssueUnknownClass is reported for classes that are not known
+	// Tailwind classes -> pading-4
+	IssueUnknownClass IssueKind = iota + 1
+	// IssueUnknownVariant is reported for variants that are not known
+	// Tailwind variants -> hovr:p-4
+	IssueUnknownVariant
+	// IssueMalformedArbitrary is reported for arbitrary values with
+	// unbalanced brackets -> w-[10px
+	IssueMalformedArbitrary
+	// IssueOverridden is reported for classes that are overridden by a
+	// later class of the same string -> p-2 in "p-2 p-4"
+	IssueOverridden
+	// IssueMalformedClass is reported for classes that can not be parsed
+	// -> hover:
+	IssueMalformedClass
+)
+
+// String returns the name of the issue kind.
+func (k IssueKind) String() string {
+	switch k {
+	case IssueUnknownClass:
+		return "unknown class"
+	case IssueUnknownVariant:
+		return "unknown variant"
+	case IssueMalformedArbitrary:
+		return "malformed arbitrary value"
+	case IssueOverridden:
+		return "overridden class"
+	case IssueMalformedClass:
+		return "malformed class"
+	default:
+		return "unknown issue"
+	}
+}
+
+// Issue is a problem found in a class string by [Validate].
+type Issue struct {
+	// Class is the class as it appeared in the input.
+	Class string
+	// Kind is the kind of the issue.
+	Kind IssueKind
+	// Message describes the issue.
+	//
+	// Example: variant "hovr" is not a known variant
+	Message string
+}
+
+// Error returns the issue as a string.
+func (i Issue) Error() string {
+	return fmt.Sprintf("%s: %s: %s", i.Class, i.Kind, i.Message)
+}
+
+// ValidationError is returned by [CodeGen] when a [Generator] created with
+// [WithValidation] finds issues.
+type ValidationError struct {
+	Issues []Issue
+}
+
+// Error returns all the issues, one per line.
+func (e *ValidationError) Error() string {
+	errs := make([]error, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		errs = append(errs, issue)
+	}
+	return errors.Join(errs...).Error()
+}
+
+// Validate reports unknown classes and variants, malformed classes and
+// arbitrary values, and overridden classes.
+//
+// The values of color classes are checked against the Tailwind CSS palette
+// and the theme colors, so that a typo is an unknown class ->
+// bg-bleu-500.
+func Validate(classes string) []Issue {
+	return Default().Validate(classes)
+}
+
+// Validate reports unknown classes and variants, malformed arbitrary values
+// and overridden classes.
+func (g *Generator) Validate(classes string) []Issue {
+	return g.mergeHandler().Validate(classes)
+}
+
+// WithValidation makes [CodeGen] validate every class string of the cache
+// and fail with a [*ValidationError] when issues are found.
+//
+// Only the given kinds of issues fail. Without kinds, every kind but
+// [IssueOverridden] fails, as overriding classes is what merging is for ->
+// Join(base, props.Class).
+func WithValidation(kinds ...IssueKind) Option {
+	return func(g *Generator) {
+		if len(kinds) == 0 {
+			kinds = []IssueKind{
+				IssueUnknownClass,
+				IssueUnknownVariant,
+				IssueMalformedArbitrary,
+				IssueMalformedClass,
+			}
+		}
+		g.issueKinds = kinds
+	}
+}
+
+// validateCache validates all the class strings of the cache.
+func (g *Generator) validateCache() error {
+	if g.issueKinds == nil {
+		return nil
+	}
+	var issues []Issue
+	for _, raw := range slices.Sorted(maps.Keys(g.Cache())) {
+		for _, issue := range g.Validate(raw) {
+			if slices.Contains(g.issueKinds, issue.Kind) {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	if len(issues) > 0 {
+		return &ValidationError{Issues: issues}
+	}
+	return nil
+}
+
+// Validate reports unknown classes and variants, malformed arbitrary values
+// and overridden classes.
+func (g *defaultHandler) Validate(classes string) []Issue {
+	var issues []Issue
+	isThemeColor := g.config.Theme.validator(ThemeColor)
+	decisions := g.resolve(classes)
+	for _, decision := range decisions {
+		if !isBalanced(decision.Class) {
+			issues = append(issues, Issue{
+				Class:   decision.Class,
+				Kind:    IssueMalformedArbitrary,
+				Message: "unbalanced brackets or parentheses",
+			})
+			continue
+		}
+		var perr *ParseError
+		if _, err := g.parse(decision.Class, nil); errors.As(err, &perr) {
+			issues = append(issues, Issue{
+				Class:   decision.Class,
+				Kind:    IssueMalformedClass,
+				Message: perr.Reason,
+			})
+			continue
+		}
+		for _, modifier := range decision.Modifiers {
+			if !g.config.isVariant(modifier) {
+				issues = append(issues, Issue{
+					Class:   decision.Class,
+					Kind:    IssueUnknownVariant,
+					Message: fmt.Sprintf("variant %q is not a known variant", modifier),
+				})
+			}
+		}
+		if !decision.IsTailwind {
+			issues = append(issues, Issue{
+				Class:   decision.Class,
+				Kind:    IssueUnknownClass,
+				Message: "not a known Tailwind class",
+			})
+			continue
+		}
+		if color, ok := g.knownColor(decision, isThemeColor); !ok {
+			issues = append(issues, Issue{
+				Class:   decision.Class,
+				Kind:    IssueUnknownClass,
+				Message: fmt.Sprintf("color %q is not a known color", color),
+			})
+		}
+		if !decision.Kept {
+			issues = append(issues, Issue{
+				Class: decision.Class,
+				Kind:  IssueOverridden,
+				Message: fmt.Sprintf(
+					"overridden by %q",
+					decisions[decision.OverriddenBy].Class,
+				),
+			})
+		}
+	}
+	return issues
+}
+
+// paletteColors are the colors of the default Tailwind CSS palette, they
+// match with their shades only -> red-500, but not red.
+var paletteColors = map[string]bool{
+	"slate": true, "gray": true, "zinc": true, "neutral": true, "stone": true,
+	"mauve": true, "olive": true, "mist": true, "taupe": true,
+	"red": true, "orange": true, "amber": true, "yellow": true, "lime": true,
+	"green": true, "emerald": true, "teal": true, "cyan": true, "sky": true,
+	"blue": true, "indigo": true, "violet": true, "purple": true,
+	"fuchsia": true, "pink": true, "rose": true,
+}
+
+// specialColors are the colors without shades, and the keywords accepted
+// by some color class groups -> fill-none.
+var specialColors = map[string]bool{
+	"inherit": true, "current": true, "transparent": true, "black": true,
+	"white": true, "none": true, "auto": true,
+}
+
+// paletteShades are the shades of the colors of the palette.
+var paletteShades = map[string]bool{
+	"50": true, "100": true, "200": true, "300": true, "400": true,
+	"500": true, "600": true, "700": true, "800": true, "900": true, "950": true,
+}
+
+// knownColor returns the color of a class of a color class group, and
+// false if it is neither a shade of the palette or a color of the theme,
+// nor an arbitrary value or a class of the config -> bleu-500 of
+// bg-bleu-500, red of bg-red.
+//
+// The color class groups accept any value, so that a typo in a color is
+// a known class otherwise.
+func (g *defaultHandler) knownColor(decision Decision, isThemeColor func(string) bool) (string, bool) {
+	prefix, ok := themeClasses[ThemeColor][decision.GroupID]
+	if !ok {
+		return "", true
+	}
+	class := g.class(decision)
+	color, ok := strings.CutPrefix(class.Base, prefix+string(g.config.ClassSeparator))
+	if !ok || strings.HasPrefix(color, "[") || strings.HasPrefix(color, "(") ||
+		specialColors[color] || isThemeColor(color) ||
+		g.table().isLiteral(class.Base, g.config.ClassSeparator) {
+		return color, true
+	}
+	name, shade, ok := cutShade(color, g.config.ClassSeparator)
+	return color, ok && paletteColors[name] && paletteShades[shade]
+}
+
+// cutShade splits the shade from a color -> red and 500 of red-500.
+func cutShade(color string, separator rune) (name, shade string, ok bool) {
+	i := strings.LastIndex(color, string(separator))
+	if i == -1 {
+		return color, "", false
+	}
+	return color[:i], color[i+utf8.RuneLen(separator):], true
+}
+
+// isVariant returns true if the modifier is a known or an arbitrary variant.
+func (c *Config) isVariant(modifier string) bool {
+	// named groups and peers -> group-hover/item
+	if i := strings.LastIndexByte(modifier, byte(c.PostfixModifier)); i > 0 &&
+		!strings.ContainsAny(modifier[i:], "])") {
+		modifier = modifier[:i]
+	}
+	// arbitrary variants -> [&>*] and arbitrary container queries -> @[500px]
+	if strings.HasPrefix(modifier, "[") || strings.HasPrefix(modifier, "@[") {
+		return true
+	}
+	return matchModifier(c.Variants, modifier) ||
+		slices.Contains(c.Theme[ThemeBreakpoint], modifier)
+}
+
+// isBalanced returns true if the brackets and parentheses of the class are
+// balanced.
+func isBalanced(class string) bool {
+	var stack []byte
+	for i := range len(class) {
+		switch class[i] {
+		case '[', '(':
+			stack = append(stack, class[i])
+		case ']', ')':
+			open := byte('[')
+			if class[i] == ')' {
+				open = '('
+			}
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return false
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return len(stack) == 0
+}
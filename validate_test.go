@@ -0,0 +1,132 @@
+package twerge
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tt := []struct {
+		in    string
+		kinds []IssueKind
+	}{
+		{
+			in:    "p-4 hover:bg-red-500 md:focus:text-lg",
+			kinds: nil,
+		}, {
+			in:    "pading-4",
+			kinds: []IssueKind{IssueUnknownClass},
+		}, {
+			in:    "hovr:p-4",
+			kinds: []IssueKind{IssueUnknownVariant},
+		}, {
+			in:    "w-[10px",
+			kinds: []IssueKind{IssueMalformedArbitrary},
+		}, {
+			in:    "w-[calc(100%-10px])",
+			kinds: []IssueKind{IssueMalformedArbitrary},
+		}, {
+			in:    "hover: p-2",
+			kinds: []IssueKind{IssueMalformedClass},
+		}, {
+			in:    "p-2 p-4",
+			kinds: []IssueKind{IssueOverridden},
+		}, {
+			in:    "bg-bleu-500 text-blue-500/50 fill-none bg-[#fff]",
+			kinds: []IssueKind{IssueUnknownClass, IssueOverridden},
+		}, {
+			in:    "hover:bg-slate-950 border-x-white ring-current from-transparent",
+			kinds: nil,
+		}, {
+			in:    "group-hover/item:p-4 aria-checked:p-2 data-[open]:p-1 [&>*]:p-3 @md:p-5 @[500px]:p-6",
+			kinds: nil,
+		}, {
+			in:    "supports-[display:grid]:grid has-[:checked]:flex max-md:hidden",
+			kinds: nil,
+		}, {
+			in:    "read-write:p-4 read-only:p-2 popover-open:block autofill:bg-white open:flex inert:hidden",
+			kinds: nil,
+		}, {
+			in:    "bg-red text-blue-500",
+			kinds: []IssueKind{IssueUnknownClass},
+		}, {
+			in:    "bg-red-450",
+			kinds: []IssueKind{IssueUnknownClass},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.in, func(t *testing.T) {
+			issues := New(newDefaultHandler()).Validate(tc.in)
+			if len(issues) != len(tc.kinds) {
+				t.Fatalf("Validate() = %v, wanted kinds %v", issues, tc.kinds)
+			}
+			for i, issue := range issues {
+				if issue.Kind != tc.kinds[i] {
+					t.Errorf("issue %d: %v, wanted kind %v", i, issue, tc.kinds[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCodeGenValidation(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "classes")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	g := New(newDefaultHandler(), WithValidation(IssueUnknownClass))
+	g.It("p-2 p-4")
+	g.It("pading-4")
+	err := CodeGen(
+		g,
+		filepath.Join(dir, "classes.go"),
+		filepath.Join(dir, "input.css"),
+		filepath.Join(dir, "classes.html"),
+	)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("CodeGen() error = %v, wanted a *ValidationError", err)
+	}
+	if len(verr.Issues) != 1 || verr.Issues[0].Class != "pading-4" {
+		t.Errorf("CodeGen() issues = %v", verr.Issues)
+	}
+}
+
+func TestValidateThemeColors(t *testing.T) {
+	cfg := ExtendConfig(ConfigExtension{
+		Extend: ConfigGroups{
+			Theme: Theme{ThemeColor: {"brand"}},
+			ClassGroups: map[string][]ClassDefinition{
+				"bg-color": {{Class: "bg-surface"}},
+			},
+		},
+	})
+	g := New(NewHandler(cfg))
+	if issues := g.Validate("bg-brand-500 text-brand bg-surface"); len(issues) != 1 ||
+		issues[0].Kind != IssueOverridden {
+		t.Errorf("Validate() = %v, wanted the colors of the config to be known", issues)
+	}
+	if issues := g.Validate("text-bleu-500"); len(issues) != 1 || issues[0].Kind != IssueUnknownClass {
+		t.Errorf("Validate() = %v, wanted an unknown color", issues)
+	}
+}
+
+func TestCodeGenValidationDefaultKinds(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "classes")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	g := New(newDefaultHandler(), WithValidation())
+	g.Join("px-4 bg-blue-500", "bg-red-500")
+	err := CodeGen(
+		g,
+		filepath.Join(dir, "classes.go"),
+		filepath.Join(dir, "input.css"),
+		filepath.Join(dir, "classes.html"),
+	)
+	if err != nil {
+		t.Errorf("CodeGen() error = %v, overridden classes should not fail by default", err)
+	}
+}